@@ -1,11 +1,27 @@
 package main
 
 import (
+	"caatsm/internal/api"
 	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"caatsm/internal/health"
+	"caatsm/internal/metrics"
 	"caatsm/internal/nats"
+	"caatsm/internal/parsers"
 	"caatsm/internal/repository"
+	"caatsm/internal/rpc"
+	"caatsm/internal/schema"
+	"caatsm/internal/stream"
+	"caatsm/internal/tracing"
 	"caatsm/pkg/utils"
+	"context"
+	"encoding/json"
+	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
 
 	"fmt"
 
@@ -20,6 +36,7 @@ func main() {
 	app := setupApp()
 	if err := app.Run(os.Args); err != nil {
 		fmt.Printf("Error running application: %v\n", err)
+		os.Exit(1)
 	}
 }
 
@@ -50,9 +67,76 @@ func setupApp() *cli.App {
 						Value:   "Telegram.Serial",
 						EnvVars: []string{"NATS_SUBJECT"},
 					},
+					&cli.StringFlag{
+						Name:    "grpc",
+						Usage:   "Address of the gRPC server of live telegrams, off if empty",
+						EnvVars: []string{"GRPC_ADDRESS"},
+					},
 				},
 				Action: executeListen,
 			},
+			{
+				Name:      "learn",
+				Usage:     "Propose a schedule line definition from sample lines",
+				ArgsUsage: "<sample file>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "airline",
+						Aliases: []string{"a"},
+						Usage:   "Airline code, taken from the flight numbers if omitted",
+					},
+				},
+				Action: executeLearn,
+			},
+			{
+				Name:  "serve",
+				Usage: "Serve HTTP endpoints parsing and validating telegrams",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "address",
+						Aliases: []string{"a"},
+						Usage:   "Address to serve on",
+						EnvVars: []string{"SERVE_ADDRESS"},
+					},
+				},
+				Action: executeServe,
+			},
+			{
+				Name:  "redrive",
+				Usage: "Send dead-lettered messages back to the subscription topic, and failed outbox records to their topic",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "nats",
+						Aliases: []string{"n"},
+						Usage:   "Nats server address",
+						EnvVars: []string{"NATS_SERVER"},
+					},
+					&cli.IntFlag{
+						Name:    "limit",
+						Aliases: []string{"l"},
+						Usage:   "Maximum number of messages to re-drive, all if 0",
+					},
+				},
+				Action: executeRedrive,
+			},
+			{
+				Name:      "schema",
+				Usage:     "Dump the JSON Schemas of the published messages",
+				ArgsUsage: "[name]",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "out",
+						Aliases: []string{"o"},
+						Usage:   "Directory to write the schemas to, as <version>/<name>.schema.json, standard output if omitted",
+					},
+					&cli.StringFlag{
+						Name:  "prefix",
+						Usage: "Prefix of the schema ids, as the CloudEvents dataschema",
+						Value: config.DefaultDataSchema,
+					},
+				},
+				Action: executeSchema,
+			},
 		},
 	}
 	return app
@@ -67,26 +151,215 @@ func overrideConfig(c *cli.Context) {
 		cfg.Subscription.Topic = c.String("topic")
 		fmt.Printf("Overriding nats topic to %s\n", cfg.Subscription.Topic)
 	}
+	if c.IsSet("grpc") {
+		cfg.GRPC.Address = c.String("grpc")
+		fmt.Printf("Overriding grpc address to %s\n", cfg.GRPC.Address)
+	}
 }
 
-func executeListen(c *cli.Context) error {
-	cfg, err := config.LoadConfig()
-	if err != nil {
+// loadConfig loads and validates the configuration, applying the command line overrides
+func loadConfig(c *cli.Context) error {
+	var err error
+	if cfg, err = config.LoadConfig(); err != nil {
 		fmt.Printf("Error loading configuration: %v\n", err)
 		return err
 	}
+	overrideConfig(c)
 	if err := config.ValidateConfig(cfg); err != nil {
 		fmt.Printf("Invalid configuration: %v\n", err)
 		return err
 	}
-	overrideConfig(c)
+	return nil
+}
+
+// executeListen handles messages until SIGINT or SIGTERM, then stops taking new
+// ones and drains the messages in flight within the close timeout
+func executeListen(c *cli.Context) error {
+	if err := loadConfig(c); err != nil {
+		return err
+	}
+	if err := loadScheduleDefinitions(cfg); err != nil {
+		fmt.Printf("Invalid schedule definitions: %v\n", err)
+		return err
+	}
 	fmt.Println("Loaded configuration successfully")
 	log := utils.GetLogger()
+	defer utils.SyncLogger()
+	shutdownTracing, err := tracing.Setup(cfg.Tracing)
+	if err != nil {
+		log.Errorf("Failed to set up tracing: %v", err)
+		return err
+	}
+	// the gRPC streams and the live feed queue telegrams in their own buffers
+	hub := stream.NewHub(cfg.GRPC.Buffer, 0)
+	liveHub := stream.NewHub(cfg.Live.Buffer, cfg.Live.Replay)
+	rpcServer := rpc.NewServer(cfg, hub)
+	if err := rpcServer.Start(); err != nil {
+		log.Errorf("Failed to start the gRPC server: %v", err)
+		return err
+	}
 	log.Info("Starting nats subscriber")
 	publisher := nats.NewPub(cfg)
 	repository := repository.NewHasura(cfg)
-	handler := nats.NewHandler(cfg, publisher, repository)
+	relay := nats.NewRelay(cfg, publisher, repository)
+	relay.Start()
+	handler := nats.NewHandler(cfg, repository)
+	handler.AddListener(hub)
+	handler.AddListener(liveHub)
 	subscriber := nats.NewSub(cfg)
-	subscriber.Subscribe(cfg, handler)
+	server := health.NewServer(cfg, subscriber, repository)
+	server.Handle("/metrics", metrics.Handler())
+	if cfg.Health.Address == "" {
+		log.Warn("No health address configured: the metrics and the live feed are not served")
+	}
+	feed := stream.NewFeed(cfg.Live, liveHub)
+	server.Handle("/live/events", http.HandlerFunc(feed.ServeEvents))
+	server.Handle("/live/ws", http.HandlerFunc(feed.ServeWebSocket))
+	server.Start()
+
+	ctx, stop := signal.NotifyContext(c.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		subscriber.Subscribe(cfg, handler)
+	}()
+
+	select {
+	case <-ctx.Done():
+		log.Infof("Shutting down, draining messages for up to %v", cfg.Timeouts.Close)
+		err = subscriber.Shutdown(cfg.Timeouts.Close)
+	case <-done:
+		err = fmt.Errorf("nats subscriber stopped")
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Timeouts.Close)
+	defer cancel()
+	feed.Close()
+	server.Shutdown(shutdownCtx)
+	rpcServer.Shutdown(shutdownCtx)
+	relay.Stop()
+	if count, flushErr := relay.Flush(); flushErr != nil {
+		log.Errorf("Failed to publish the outbox, %d records left for the next start: %v", count, flushErr)
+	}
+	publisher.Close()
+	if tracingErr := shutdownTracing(shutdownCtx); tracingErr != nil {
+		log.Errorf("Failed to flush the traces: %v", tracingErr)
+	}
+	if err != nil {
+		log.Errorf("Stopped with an error: %v", err)
+		return err
+	}
+	log.Info("Stopped")
+	return nil
+}
+
+func executeLearn(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("expected a sample file, got %d arguments", c.NArg())
+	}
+	data, err := os.ReadFile(c.Args().First())
+	if err != nil {
+		fmt.Printf("Error reading samples: %v\n", err)
+		return err
+	}
+	def, report, err := parsers.LearnLineParser(c.String("airline"), strings.Split(string(data), "\n"))
+	if err != nil {
+		fmt.Printf("Unable to learn a definition: %v\n", err)
+		return err
+	}
+	fmt.Println(parsers.FormatLineParser(def))
+	fmt.Print(report)
+	return nil
+}
+
+// executeServe serves the parsing endpoints until SIGINT or SIGTERM
+func executeServe(c *cli.Context) error {
+	if err := loadConfig(c); err != nil {
+		return err
+	}
+	if c.IsSet("address") {
+		cfg.Serve.Address = c.String("address")
+	}
+	if err := loadScheduleDefinitions(cfg); err != nil {
+		fmt.Printf("Invalid schedule definitions: %v\n", err)
+		return err
+	}
+	log := utils.GetLogger()
+	defer utils.SyncLogger()
+	server := api.NewServer(cfg)
+	if err := server.Start(); err != nil {
+		log.Errorf("Failed to start the parsing server: %v", err)
+		return err
+	}
+	ctx, stop := signal.NotifyContext(c.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Timeouts.Close)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Errorf("Stopped with an error: %v", err)
+		return err
+	}
+	log.Info("Stopped")
 	return nil
 }
+
+func executeRedrive(c *cli.Context) error {
+	if err := loadConfig(c); err != nil {
+		return err
+	}
+	count, err := nats.Redrive(cfg, c.Int("limit"))
+	fmt.Printf("Re-drove %d messages to %s\n", count, cfg.Subscription.Topic)
+	if err != nil {
+		fmt.Printf("Error re-driving messages: %v\n", err)
+	}
+	return err
+}
+
+// executeSchema prints the schema of the given name, or all of them, or writes
+// them to a directory of the schema version under the output directory
+func executeSchema(c *cli.Context) error {
+	names := schema.Names()
+	if c.NArg() > 0 {
+		names = c.Args().Slice()
+	}
+	out := c.String("out")
+	if out != "" {
+		out = filepath.Join(out, domain.SchemaVersion)
+		if err := os.MkdirAll(out, 0o755); err != nil {
+			return err
+		}
+	}
+	for _, name := range names {
+		document := schema.For(c.String("prefix"), name)
+		if document == nil {
+			return fmt.Errorf("unknown schema %s, expected one of %s", name, strings.Join(schema.Names(), ", "))
+		}
+		data, err := json.MarshalIndent(document, "", "  ")
+		if err != nil {
+			return err
+		}
+		if out == "" {
+			fmt.Println(string(data))
+			continue
+		}
+		file := filepath.Join(out, name+".schema.json")
+		if err := os.WriteFile(file, append(data, '\n'), 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("Wrote %s\n", file)
+	}
+	return nil
+}
+
+// loadScheduleDefinitions adds the configured airline layouts to the built-in ones
+func loadScheduleDefinitions(cfg *config.Config) error {
+	if cfg.Schedule.Definitions == "" {
+		return nil
+	}
+	defs, err := parsers.LoadLineParsers(cfg.Schedule.Definitions)
+	if err != nil {
+		return err
+	}
+	return parsers.RegisterLineParsers(defs)
+}
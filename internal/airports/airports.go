@@ -0,0 +1,139 @@
+package airports
+
+import (
+	"caatsm/internal/domain"
+	"caatsm/pkg/utils"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata"
+)
+
+//go:embed airports.csv
+var airportsCSV string
+
+var (
+	once      sync.Once
+	byICAO    map[string]domain.Airport
+	byIATA    map[string]domain.Airport
+	locations sync.Map
+)
+
+func load() {
+	once.Do(func() {
+		byICAO = make(map[string]domain.Airport)
+		byIATA = make(map[string]domain.Airport)
+		airports, err := parse(airportsCSV)
+		if err != nil {
+			utils.GetSugaredLogger().Errorf("failed to load airport data: %v", err)
+			return
+		}
+		for _, airport := range airports {
+			byICAO[airport.ICAO] = airport
+			if airport.IATA != "" {
+				byIATA[airport.IATA] = airport
+			}
+		}
+	})
+}
+
+func parse(data string) ([]domain.Airport, error) {
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var airports []domain.Airport
+	// skip the header row
+	for i, record := range records[1:] {
+		if len(record) != 8 {
+			return nil, fmt.Errorf("line %d: expected 8 fields, got %d", i+2, len(record))
+		}
+		lat, err := strconv.ParseFloat(record[4], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid latitude: %v", i+2, err)
+		}
+		lon, err := strconv.ParseFloat(record[5], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid longitude: %v", i+2, err)
+		}
+		elevation, err := strconv.Atoi(record[6])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid elevation: %v", i+2, err)
+		}
+		airports = append(airports, domain.Airport{
+			ICAO:      record[0],
+			IATA:      record[1],
+			Name:      record[2],
+			Country:   record[3],
+			Latitude:  lat,
+			Longitude: lon,
+			Elevation: elevation,
+			Timezone:  record[7],
+		})
+	}
+	return airports, nil
+}
+
+// ByICAO looks up an airport by its ICAO location indicator, e.g. "ZBTJ".
+func ByICAO(code string) (domain.Airport, bool) {
+	load()
+	airport, found := byICAO[strings.ToUpper(code)]
+	return airport, found
+}
+
+// ByIATA looks up an airport by its IATA code, e.g. "TSN".
+func ByIATA(code string) (domain.Airport, bool) {
+	load()
+	airport, found := byIATA[strings.ToUpper(code)]
+	return airport, found
+}
+
+// ICAOFromIATA maps an IATA airport code to its ICAO location indicator,
+// returning an empty string when the airport is unknown.
+func ICAOFromIATA(code string) string {
+	if airport, found := ByIATA(code); found {
+		return airport.ICAO
+	}
+	return ""
+}
+
+// Lookup returns the known airports for the given ICAO codes, skipping unknown ones.
+func Lookup(codes []string) []domain.Airport {
+	var result []domain.Airport
+	for _, code := range codes {
+		if airport, found := ByICAO(code); found {
+			result = append(result, airport)
+		}
+	}
+	return result
+}
+
+// Location returns the time zone of an airport, or nil when the zone is unknown.
+func Location(airport domain.Airport) *time.Location {
+	if cached, found := locations.Load(airport.Timezone); found {
+		return cached.(*time.Location)
+	}
+	if airport.Timezone == "" {
+		return nil
+	}
+	location, err := time.LoadLocation(airport.Timezone)
+	if err != nil {
+		utils.GetSugaredLogger().Warnf("unknown time zone for %s: %s", airport.ICAO, airport.Timezone)
+		return nil
+	}
+	locations.Store(airport.Timezone, location)
+	return location
+}
+
+// LocationByIATA returns the time zone of the airport with the given IATA code,
+// or nil when the airport is unknown.
+func LocationByIATA(code string) *time.Location {
+	if airport, found := ByIATA(code); found {
+		return Location(airport)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package airports
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Airports", func() {
+
+	It("should load every row of the embedded dataset", func() {
+		airports, err := parse(airportsCSV)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(airports).NotTo(BeEmpty())
+		for _, airport := range airports {
+			Expect(airport.ICAO).To(HaveLen(4))
+			Expect(airport.Timezone).NotTo(BeEmpty())
+		}
+	})
+
+	It("should look up an airport by ICAO code", func() {
+		airport, found := ByICAO("ZBTJ")
+		Expect(found).To(BeTrue())
+		Expect(airport.IATA).To(Equal("TSN"))
+		Expect(airport.Country).To(Equal("CN"))
+		Expect(airport.Timezone).To(Equal("Asia/Shanghai"))
+	})
+
+	It("should look up an airport by IATA code", func() {
+		airport, found := ByIATA("pvg")
+		Expect(found).To(BeTrue())
+		Expect(airport.ICAO).To(Equal("ZSPD"))
+	})
+
+	It("should map IATA codes to ICAO codes", func() {
+		Expect(ICAOFromIATA("ICN")).To(Equal("RKSI"))
+		Expect(ICAOFromIATA("XXX")).To(BeEmpty())
+	})
+
+	It("should skip unknown airports", func() {
+		airports := Lookup([]string{"ZBTJ", "XXXX", "ZSHC"})
+		Expect(airports).To(HaveLen(2))
+		Expect(airports[0].ICAO).To(Equal("ZBTJ"))
+		Expect(airports[1].ICAO).To(Equal("ZSHC"))
+	})
+
+	It("should reject a malformed row", func() {
+		_, err := parse("icao,iata,name,country,latitude,longitude,elevation,timezone\nZBTJ,TSN,Tianjin,CN,north,117.3,10,Asia/Shanghai\n")
+		Expect(err).To(HaveOccurred())
+	})
+})
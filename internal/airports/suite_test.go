@@ -0,0 +1,13 @@
+package airports
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAirports(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Airports Suite")
+}
@@ -0,0 +1,168 @@
+// Package api serves the parsers over HTTP, to parse and validate telegrams on
+// request without NATS or Hasura.
+package api
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"caatsm/internal/parsers"
+	"caatsm/pkg/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// TextRequest is the JSON form of a request body; a plain text body is the text itself
+type TextRequest struct {
+	Text string `json:"text"` // 报文: The raw telegram, or the schedule lines.
+}
+
+// Validation is the body of the validate endpoint
+type Validation struct {
+	Valid          bool                    `json:"valid"`                    // 有效: The listener would save and publish the telegram.
+	Stage          string                  `json:"stage,omitempty"`          // 阶段: Where the telegram is rejected, parse or validation.
+	Reason         string                  `json:"reason,omitempty"`         // 原因: Why the telegram is rejected.
+	Category       string                  `json:"category,omitempty"`       // 类别: Category of the telegram.
+	HeaderErrors   []domain.HeaderError    `json:"headerErrors,omitempty"`   // 报头错误: Invalid header fields that reject the telegram.
+	HeaderWarnings []domain.HeaderError    `json:"headerWarnings,omitempty"` // 报头警告: Invalid header fields that are only reported.
+	Schedule       *domain.ScheduleSummary `json:"schedule,omitempty"`       // 航班计划: Line counts of a schedule telegram.
+}
+
+// Error is the body of a failed request
+type Error struct {
+	Error string `json:"error"` // 错误: What was wrong with the request.
+}
+
+// Server serves POST /parse, the telegram as a ParsedMessage, POST
+// /parse/schedule, schedule lines as a Schedule with the diagnostics of each
+// line, and POST /validate, whether the listener would accept the telegram.
+// Each one takes the text as a plain body or as a JSON TextRequest.
+type Server struct {
+	config *config.Config
+	mux    *http.ServeMux
+	server *http.Server
+}
+
+func NewServer(config *config.Config) *Server {
+	s := &Server{config: config, mux: http.NewServeMux()}
+	s.mux.HandleFunc("POST /parse", s.parse)
+	s.mux.HandleFunc("POST /parse/schedule", s.parseSchedule)
+	s.mux.HandleFunc("POST /validate", s.validate)
+	return s
+}
+
+// Handler returns the handler of all the endpoints
+func (s *Server) Handler() http.Handler {
+	return s.mux
+}
+
+// Start serves the endpoints on the configured address until Shutdown is called
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.config.Serve.Address)
+	if err != nil {
+		return err
+	}
+	s.server = &http.Server{Handler: s.mux}
+	go func() {
+		utils.GetSugaredLogger().Infof("Serving the parsing endpoints on %s", listener.Addr())
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			utils.GetSugaredLogger().Errorf("Parsing server stopped: %v", err)
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops the server, waiting for the requests being served
+func (s *Server) Shutdown(ctx context.Context) error {
+	if s.server == nil {
+		return nil
+	}
+	return s.server.Shutdown(ctx)
+}
+
+func (s *Server) parse(w http.ResponseWriter, r *http.Request) {
+	text, ok := s.read(w, r)
+	if !ok {
+		return
+	}
+	parsed, _ := parsers.ParseTelegram(text)
+	write(w, http.StatusOK, parsed)
+}
+
+func (s *Server) parseSchedule(w http.ResponseWriter, r *http.Request) {
+	text, ok := s.read(w, r)
+	if !ok {
+		return
+	}
+	write(w, http.StatusOK, parsers.ParseSchedule(text, time.Now()))
+}
+
+func (s *Server) validate(w http.ResponseWriter, r *http.Request) {
+	text, ok := s.read(w, r)
+	if !ok {
+		return
+	}
+	parsed, schedule := parsers.ParseTelegram(text)
+	validation := Validation{Valid: true, Category: parsed.Category,
+		HeaderErrors: parsed.HeaderErrors, HeaderWarnings: parsed.HeaderWarnings}
+	if schedule != nil {
+		validation.Schedule = &schedule.Summary
+	}
+	if rejected := parsers.Validate(parsed, schedule); rejected != nil {
+		validation.Valid = false
+		validation.Stage = rejected.Stage
+		validation.Reason = rejected.Reason
+	}
+	write(w, http.StatusOK, validation)
+}
+
+// read returns the text of a request, or writes the error and returns false
+func (s *Server) read(w http.ResponseWriter, r *http.Request) (string, bool) {
+	if s.config.Serve.MaxBody > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, s.config.Serve.MaxBody)
+	}
+	text, err := readText(r)
+	var tooLarge *http.MaxBytesError
+	switch {
+	case errors.As(err, &tooLarge):
+		write(w, http.StatusRequestEntityTooLarge, Error{Error: fmt.Sprintf("body larger than %d bytes", tooLarge.Limit)})
+		return "", false
+	case err != nil:
+		write(w, http.StatusBadRequest, Error{Error: err.Error()})
+		return "", false
+	case strings.TrimSpace(text) == "":
+		write(w, http.StatusBadRequest, Error{Error: "text is required"})
+		return "", false
+	}
+	return text, true
+}
+
+// readText reads the text of a JSON TextRequest or of a plain body
+func readText(r *http.Request) (string, error) {
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "application/json" {
+		var request TextRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				return "", err
+			}
+			return "", fmt.Errorf("invalid JSON request: %v", err)
+		}
+		return request.Text, nil
+	}
+	body, err := io.ReadAll(r.Body)
+	return string(body), err
+}
+
+func write(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(body)
+}
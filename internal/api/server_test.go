@@ -0,0 +1,119 @@
+package api
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const arr = `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+
+var _ = Describe("Server", func() {
+	var server *Server
+
+	post := func(path, contentType, body string, response interface{}) int {
+		request := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		request.Header.Set("Content-Type", contentType)
+		recorder := httptest.NewRecorder()
+		server.Handler().ServeHTTP(recorder, request)
+		if response != nil {
+			Expect(json.Unmarshal(recorder.Body.Bytes(), response)).To(Succeed())
+		}
+		return recorder.Code
+	}
+
+	BeforeEach(func() {
+		server = NewServer(&config.Config{Serve: config.ServeConfig{MaxBody: 1024}})
+	})
+
+	It("should parse a plain text telegram", func() {
+		var parsed struct {
+			domain.ParsedMessage
+			BodyData domain.ARR `json:"bodyData"`
+		}
+		Expect(post("/parse", "text/plain", arr, &parsed)).To(Equal(http.StatusOK))
+		Expect(parsed.Parsed).To(BeTrue())
+		Expect(parsed.Category).To(Equal("ARR"))
+		Expect(parsed.Originator).To(Equal("ZSHCZTZX"))
+		Expect(parsed.BodyData.AircraftID).To(Equal("CES5470"))
+	})
+
+	It("should parse a telegram sent as JSON", func() {
+		body, err := json.Marshal(TextRequest{Text: arr})
+		Expect(err).NotTo(HaveOccurred())
+		var parsed domain.ParsedMessage
+		Expect(post("/parse", "application/json; charset=utf-8", string(body), &parsed)).To(Equal(http.StatusOK))
+		Expect(parsed.Category).To(Equal("ARR"))
+	})
+
+	It("should parse schedule lines with the diagnostics of each line", func() {
+		lines := `HU TSN SCHEDULE FOR 31OCT
+L05 W/Z HU7205 B5406 (9) TSN/2355(30OCT) PVG
+L06 W/Z HU7206 B5406 (9) PVG/0300 TSN
+???`
+		var schedule domain.Schedule
+		Expect(post("/parse/schedule", "text/plain", lines, &schedule)).To(Equal(http.StatusOK))
+		Expect(schedule.Airline).To(Equal("HU"))
+		Expect(schedule.Lines).To(HaveLen(2))
+		Expect(schedule.Lines[0].FlightNumber).To(Equal([]string{"HU7205"}))
+		Expect(schedule.Summary.Skipped).To(Equal(1))
+		Expect(schedule.Diagnostics[len(schedule.Diagnostics)-1].LineNumber).To(Equal(4))
+	})
+
+	It("should validate a telegram the listener accepts", func() {
+		var validation Validation
+		Expect(post("/validate", "text/plain", arr, &validation)).To(Equal(http.StatusOK))
+		Expect(validation).To(Equal(Validation{Valid: true, Category: "ARR"}))
+	})
+
+	It("should tell why a telegram is rejected", func() {
+		var validation Validation
+		Expect(post("/validate", "text/plain", strings.Replace(arr, "GG ZBTJZXZX", "GG ZBTJ", 1), &validation)).To(Equal(http.StatusOK))
+		Expect(validation.Valid).To(BeFalse())
+		Expect(validation.Stage).To(Equal(domain.StageValidation))
+		Expect(validation.Reason).To(ContainSubstring("primaryAddress"))
+		Expect(validation.HeaderErrors).NotTo(BeEmpty())
+
+		Expect(post("/validate", "text/plain", "NOT A TELEGRAM", &validation)).To(Equal(http.StatusOK))
+		Expect(validation.Stage).To(Equal(domain.StageParse))
+	})
+
+	It("should summarise the lines of a schedule telegram", func() {
+		text := `ZCZC TAD123 301200
+GG ZBTJZPZX ZBTJKCHU
+301158 ZBTJHUXX
+HU TSN SCHEDULE FOR 31OCT
+L05 W/Z HU7205 B5406 (9) TSN/2355(30OCT) PVG
+NNNN`
+		var validation Validation
+		Expect(post("/validate", "text/plain", text, &validation)).To(Equal(http.StatusOK))
+		Expect(validation.Valid).To(BeTrue())
+		Expect(validation.Schedule.Parsed).To(Equal(1))
+	})
+
+	It("should reject an empty, invalid or too large request", func() {
+		var response Error
+		Expect(post("/parse", "text/plain", " \n", &response)).To(Equal(http.StatusBadRequest))
+		Expect(response.Error).To(Equal("text is required"))
+		Expect(post("/parse", "application/json", "{", &response)).To(Equal(http.StatusBadRequest))
+		Expect(response.Error).To(ContainSubstring("invalid JSON request"))
+		Expect(post("/validate", "text/plain", strings.Repeat("A", 2048), &response)).To(Equal(http.StatusRequestEntityTooLarge))
+		Expect(post("/parse/schedule", "application/json", `{"text":"`+strings.Repeat("A", 2048)+`"}`, &response)).To(Equal(http.StatusRequestEntityTooLarge))
+	})
+
+	It("should only take POST requests", func() {
+		recorder := httptest.NewRecorder()
+		server.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/parse", nil))
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"caatsm/internal/domain"
 	"fmt"
 	"os"
 	"regexp"
@@ -14,10 +15,20 @@ var MyConfig *Config
 
 type Config struct {
 	Nats         NatsConfig
+	JetStream    JetStreamConfig `mapstructure:"jetstream"`
 	Subscription SubscriptionConfig
 	Publisher    PublisherConfig
 	Timeouts     TimeoutsConfig
 	Hasura       HasuraConfig
+	Schedule     ScheduleConfig
+	Retry        RetriesConfig `mapstructure:"retry"`
+	Outbox       OutboxConfig  `mapstructure:"outbox"`
+	Dedup        DedupConfig   `mapstructure:"dedup"`
+	Health       HealthConfig  `mapstructure:"health"`
+	GRPC         GRPCConfig    `mapstructure:"grpc"`
+	Serve        ServeConfig   `mapstructure:"serve"`
+	Live         LiveConfig    `mapstructure:"live"`
+	Tracing      TracingConfig `mapstructure:"tracing"`
 }
 
 type NatsConfig struct {
@@ -26,13 +37,63 @@ type NatsConfig struct {
 	Cluster string
 }
 
+// JetStreamConfig enables at-least-once delivery through a JetStream stream.
+// When disabled, or when the server has no JetStream, core NATS is used.
+type JetStreamConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Stream  string `mapstructure:"stream"`
+	// Durable is the consumer name that keeps the delivery position across restarts
+	Durable string `mapstructure:"durable"`
+	// AckPolicy is one of explicit, all or none
+	AckPolicy  string `mapstructure:"ack_policy"`
+	MaxDeliver int    `mapstructure:"max_deliver"`
+}
+
 type SubscriptionConfig struct {
-	Topic      string `mapstructure:"topic"`
+	Topic string `mapstructure:"topic"`
+	// QueueGroup lets several instances share the messages of the topic
 	QueueGroup string `mapstructure:"queue_group"`
+	// Workers is the number of messages handled in parallel
+	Workers int `mapstructure:"workers"`
 }
 
 type PublisherConfig struct {
-	Topic string `mapstructure:"topic"`
+	Topic         string `mapstructure:"topic"`
+	ScheduleTopic string `mapstructure:"schedule_topic"`
+	// DeadLetterTopic receives the telegrams that could not be parsed, validated or saved
+	DeadLetterTopic string `mapstructure:"dead_letter_topic"`
+	// SubjectTemplate, when set, replaces Topic for the parsed messages, e.g.
+	// 'Telegram.Json.{category}.{originator}.{dep}', so consumers can subscribe
+	// with wildcards
+	SubjectTemplate string `mapstructure:"subject_template"`
+	// SubjectFallback replaces a placeholder without a value
+	SubjectFallback string `mapstructure:"subject_fallback"`
+	// CloudEvents wraps the published messages in CloudEvents
+	CloudEvents CloudEventsConfig `mapstructure:"cloudevents"`
+	// Format of the published messages, json or protobuf
+	Format string `mapstructure:"format"`
+}
+
+// CloudEventsConfig sets how the published messages are wrapped in CloudEvents 1.0
+type CloudEventsConfig struct {
+	// Mode is none, structured, with the event as the JSON body, or binary,
+	// with the attributes in 'ce-' NATS headers and the message as the body
+	Mode string `mapstructure:"mode"`
+	// TypePrefix is followed by the lower case category in the event type
+	TypePrefix string `mapstructure:"type_prefix"`
+	// SourcePrefix is followed by the originator address in the event source
+	SourcePrefix string `mapstructure:"source_prefix"`
+	// DataSchema is followed by the schema name, parsed-message or schedule, and
+	// the schema version in the dataschema, e.g. urn:caatsm:schema:schedule/1.0.0
+	DataSchema string `mapstructure:"dataschema"`
+}
+
+// Subject returns the subject template of the parsed messages, nil when none is configured
+func (c PublisherConfig) Subject() (*domain.SubjectTemplate, error) {
+	if c.SubjectTemplate == "" {
+		return nil, nil
+	}
+	return domain.ParseSubjectTemplate(c.SubjectTemplate, c.SubjectFallback)
 }
 
 type TimeoutsConfig struct {
@@ -42,6 +103,121 @@ type TimeoutsConfig struct {
 	AckWait       time.Duration `mapstructure:"ack_wait"`
 }
 
+// OutboxConfig sets how often the relay publishes the saved outbox records
+type OutboxConfig struct {
+	Interval time.Duration `mapstructure:"interval"`
+	// Batch is the number of records read from the outbox at a time
+	Batch int `mapstructure:"batch"`
+	// MaxAttempts is the number of flushes that try a record before it is sent
+	// to the dead-letter topic; 0 keeps trying
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// Lease is how long a record claimed by a relay is kept from the others
+	Lease time.Duration `mapstructure:"lease"`
+}
+
+// GRPCConfig sets the gRPC server streaming the parsed telegrams and parsing
+// telegrams on demand. An empty address turns the server off.
+type GRPCConfig struct {
+	Address string `mapstructure:"address"`
+	// Buffer is how many telegrams wait for a client before new ones are dropped
+	Buffer int `mapstructure:"buffer"`
+	// Reflection registers the reflection service, for clients such as grpcurl
+	Reflection bool `mapstructure:"reflection"`
+}
+
+// LiveConfig sets the live feed of the parsed telegrams, served on the health
+// address as Server-Sent Events and over WebSocket: without a health address
+// there is no live feed.
+type LiveConfig struct {
+	// Buffer is how many telegrams wait for a client before new ones are dropped
+	Buffer int `mapstructure:"buffer"`
+	// Replay is how many of the latest telegrams are sent to a new client
+	Replay int `mapstructure:"replay"`
+	// KeepAlive is how often an idle client is pinged
+	KeepAlive time.Duration `mapstructure:"keep_alive"`
+	// AllowedOrigins are the browser origins allowed besides the same host, '*' for any
+	AllowedOrigins []string `mapstructure:"allowed_origins"`
+}
+
+// ServeConfig sets the HTTP server of the serve command, parsing and
+// validating telegrams on request
+type ServeConfig struct {
+	Address string `mapstructure:"address"`
+	// MaxBody is the largest request body accepted, in bytes
+	MaxBody int64 `mapstructure:"max_body"`
+}
+
+// HealthConfig sets the HTTP server of the health, readiness and metrics endpoints.
+// An empty address turns the server off.
+type HealthConfig struct {
+	Address string `mapstructure:"address"`
+	// StallTimeout is how long messages may wait without any being handled before the process is reported unhealthy
+	StallTimeout time.Duration `mapstructure:"stall_timeout"`
+	// CheckTimeout bounds the Hasura check of the readiness endpoint
+	CheckTimeout time.Duration `mapstructure:"check_timeout"`
+}
+
+// TracingConfig sets where the OpenTelemetry spans are exported
+type TracingConfig struct {
+	// Exporter is one of none, otlp, stdout or file
+	Exporter string `mapstructure:"exporter"`
+	// Endpoint is the host:port of the OTLP HTTP collector
+	Endpoint string `mapstructure:"endpoint"`
+	Insecure bool   `mapstructure:"insecure"`
+	// File receives the spans of the file exporter, one JSON object per span
+	File        string  `mapstructure:"file"`
+	ServiceName string  `mapstructure:"service_name"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+// DedupConfig sets how long a telegram is remembered to skip its duplicates.
+// A window of 0 turns the check off; the repository still ignores duplicates.
+type DedupConfig struct {
+	Window time.Duration `mapstructure:"window"`
+	// Size is the most telegrams remembered at once
+	Size int `mapstructure:"size"`
+}
+
+// RetriesConfig holds the retry policy of each sink a message is written to
+type RetriesConfig struct {
+	Repository RetryConfig `mapstructure:"repository"`
+	Publisher  RetryConfig `mapstructure:"publisher"`
+}
+
+// RetryConfig is an exponential backoff policy
+type RetryConfig struct {
+	// Attempts is the total number of tries, 1 or less means no retry
+	Attempts        int           `mapstructure:"attempts"`
+	InitialInterval time.Duration `mapstructure:"initial_interval"`
+	MaxInterval     time.Duration `mapstructure:"max_interval"`
+	Multiplier      float64       `mapstructure:"multiplier"`
+}
+
+// Interval returns the wait before the given retry, counted from 1
+func (r RetryConfig) Interval(retry int) time.Duration {
+	interval := float64(r.InitialInterval)
+	multiplier := r.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	for i := 1; i < retry; i++ {
+		interval *= multiplier
+	}
+	if r.MaxInterval > 0 && time.Duration(interval) > r.MaxInterval {
+		return r.MaxInterval
+	}
+	return time.Duration(interval)
+}
+
+// Budget returns the longest total wait between the attempts
+func (r RetryConfig) Budget() time.Duration {
+	var budget time.Duration
+	for retry := 1; retry < r.Attempts; retry++ {
+		budget += r.Interval(retry)
+	}
+	return budget
+}
+
 type BodyConfig struct {
 	Patterns []PatternConfig
 }
@@ -57,6 +233,30 @@ type HasuraConfig struct {
 	Secret   string
 }
 
+// ScheduleConfig points to the airline schedule line definitions
+type ScheduleConfig struct {
+	// Definitions is a TOML or YAML file adding or overriding airline layouts
+	Definitions string `mapstructure:"definitions"`
+}
+
+const (
+	// DefaultScheduleTopic is used when no publisher schedule_topic is configured
+	DefaultScheduleTopic = "Telegram.Schedule"
+	// DefaultDeadLetterTopic is used when no publisher dead_letter_topic is configured
+	DefaultDeadLetterTopic = "Telegram.DeadLetter"
+	// DefaultWorkers is used when no subscription workers are configured
+	DefaultWorkers = 4
+	// WorkerQueueSize is the number of messages waiting for each worker
+	WorkerQueueSize = 16
+	// DefaultStream and DefaultDurable name the JetStream stream and consumer when not configured
+	DefaultStream  = "TELEGRAMS"
+	DefaultDurable = "caatsm"
+	// DefaultEventTypePrefix, DefaultEventSourcePrefix and DefaultDataSchema build the CloudEvents attributes
+	DefaultEventTypePrefix   = "caatsm.telegram"
+	DefaultEventSourcePrefix = "/aftn"
+	DefaultDataSchema        = "urn:caatsm:schema:"
+)
+
 const (
 	EnvProd = "prod"
 	EnvDev  = "dev"
@@ -92,6 +292,44 @@ func LoadConfig() (*Config, error) {
 	viper.AddConfigPath("configs")
 	viper.SetEnvPrefix("tele")
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	viper.SetDefault("publisher.schedule_topic", DefaultScheduleTopic)
+	viper.SetDefault("publisher.dead_letter_topic", DefaultDeadLetterTopic)
+	viper.SetDefault("publisher.subject_fallback", domain.DefaultSubjectFallback)
+	viper.SetDefault("publisher.format", "json")
+	viper.SetDefault("publisher.cloudevents.mode", "none")
+	viper.SetDefault("publisher.cloudevents.type_prefix", DefaultEventTypePrefix)
+	viper.SetDefault("publisher.cloudevents.source_prefix", DefaultEventSourcePrefix)
+	viper.SetDefault("publisher.cloudevents.dataschema", DefaultDataSchema)
+	viper.SetDefault("subscription.workers", DefaultWorkers)
+	viper.SetDefault("jetstream.stream", DefaultStream)
+	viper.SetDefault("jetstream.durable", DefaultDurable)
+	viper.SetDefault("jetstream.ack_policy", "explicit")
+	viper.SetDefault("jetstream.max_deliver", 5)
+	viper.SetDefault("timeouts.close", "10s")
+	viper.SetDefault("outbox.interval", "1s")
+	viper.SetDefault("outbox.batch", 100)
+	viper.SetDefault("outbox.max_attempts", 5)
+	viper.SetDefault("outbox.lease", "30s")
+	viper.SetDefault("health.address", ":8081")
+	viper.SetDefault("health.stall_timeout", "1m")
+	viper.SetDefault("health.check_timeout", "2s")
+	viper.SetDefault("grpc.buffer", 100)
+	viper.SetDefault("serve.address", ":8082")
+	viper.SetDefault("serve.max_body", 1<<20)
+	viper.SetDefault("live.buffer", 100)
+	viper.SetDefault("live.replay", 20)
+	viper.SetDefault("live.keep_alive", "15s")
+	viper.SetDefault("tracing.exporter", "none")
+	viper.SetDefault("tracing.service_name", "caatsm")
+	viper.SetDefault("tracing.sample_ratio", 1.0)
+	viper.SetDefault("dedup.window", "10m")
+	viper.SetDefault("dedup.size", 10000)
+	for _, sink := range []string{"retry.repository", "retry.publisher"} {
+		viper.SetDefault(sink+".attempts", 3)
+		viper.SetDefault(sink+".initial_interval", "200ms")
+		viper.SetDefault(sink+".max_interval", "2s")
+		viper.SetDefault(sink+".multiplier", 2.0)
+	}
 
 	if err := viper.ReadInConfig(); err != nil {
 		errMsg := fmt.Sprintf("error reading config file for environment '%s': %v", env, err)
@@ -99,6 +337,8 @@ func LoadConfig() (*Config, error) {
 		return nil, fmt.Errorf(errMsg)
 	}
 
+	applyLegacyKeys(viper.GetViper())
+
 	var config Config
 	if err := viper.Unmarshal(&config); err != nil {
 		errMsg := fmt.Sprintf("unable to decode config into struct for environment '%s': %v", env, err)
@@ -108,6 +348,21 @@ func LoadConfig() (*Config, error) {
 	return &config, nil
 }
 
+// legacyKeys maps keys of earlier configuration files to their current name
+var legacyKeys = map[string]string{
+	"subscription.queue": "subscription.queue_group",
+}
+
+// applyLegacyKeys copies the value of a legacy key to its current name, unless that is set too
+func applyLegacyKeys(v *viper.Viper) {
+	for legacy, key := range legacyKeys {
+		if v.IsSet(legacy) && !v.IsSet(key) {
+			fmt.Printf("config key '%s' is deprecated, use '%s'\n", legacy, key)
+			v.Set(key, v.Get(legacy))
+		}
+	}
+}
+
 // ValidateConfig validates the loaded configuration
 func ValidateConfig(cfg *Config) error {
 	// log := utils.Logger
@@ -121,6 +376,50 @@ func ValidateConfig(cfg *Config) error {
 	if cfg.Subscription.Topic == "" {
 		return fmt.Errorf("subscription topic is required")
 	}
+	if _, err := cfg.Publisher.Subject(); err != nil {
+		return err
+	}
+	switch cfg.Publisher.CloudEvents.Mode {
+	case "", "none", domain.CloudEventsStructured, domain.CloudEventsBinary:
+	default:
+		return fmt.Errorf("invalid cloudevents mode: %s", cfg.Publisher.CloudEvents.Mode)
+	}
+	switch cfg.Publisher.Format {
+	case "", "json":
+	case "protobuf":
+		if cfg.Publisher.CloudEvents.Mode == domain.CloudEventsStructured {
+			return fmt.Errorf("protobuf messages cannot be wrapped in structured cloudevents, use binary mode")
+		}
+	default:
+		return fmt.Errorf("invalid publisher format: %s", cfg.Publisher.Format)
+	}
+	switch cfg.Tracing.Exporter {
+	case "", "none", "otlp", "stdout":
+	case "file":
+		if cfg.Tracing.File == "" {
+			return fmt.Errorf("tracing file is required for the file exporter")
+		}
+	default:
+		return fmt.Errorf("invalid tracing exporter: %s", cfg.Tracing.Exporter)
+	}
+	if cfg.JetStream.Enabled {
+		if cfg.JetStream.Stream == "" || cfg.JetStream.Durable == "" {
+			return fmt.Errorf("jetstream stream and durable are required")
+		}
+		switch cfg.JetStream.AckPolicy {
+		case "", "explicit", "all", "none":
+		default:
+			return fmt.Errorf("invalid jetstream ack_policy: %s", cfg.JetStream.AckPolicy)
+		}
+		// publishing is retried by the outbox relay, after the message is acked.
+		// A message is marked in progress when queued and when handled, so it
+		// waits for the retries of the messages queued before it on its worker.
+		budget := cfg.Retry.Repository.Budget() * WorkerQueueSize
+		if cfg.Timeouts.AckWait > 0 && budget >= cfg.Timeouts.AckWait {
+			return fmt.Errorf("retries of the %d messages queued on a worker can wait %v, longer than ack_wait %v",
+				WorkerQueueSize, budget, cfg.Timeouts.AckWait)
+		}
+	}
 	// fmt.Println("config validation passed")
 	return nil
 }
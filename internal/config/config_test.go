@@ -2,7 +2,9 @@ package config
 
 import (
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -34,10 +36,16 @@ topic = "example-topic"
 queue_group = "example-group"
 
 [timeouts]
-server_timeout = "30s"
+server = "30s"
 reconnect_wait = "10s"
-close_timeout = "10s"
-ack_wait_timeout = "5s"
+close = "10s"
+ack_wait = "5s"
+
+[retry.repository]
+attempts = 3
+initial_interval = "200ms"
+max_interval = "2s"
+multiplier = 2.0
 
 [hasura]
 endpoint = "http://localhost:8080/v1/graphql"
@@ -73,8 +81,31 @@ secret  = "aviation-test"
 			Expect(cfg.Nats.URL).To(Equal("nats://localhost:4222"))
 			Expect(cfg.Subscription.Topic).To(Equal("example-topic"))
 			Expect(cfg.Subscription.QueueGroup).To(Equal("example-group"))
+			Expect(cfg.Timeouts.Server).To(Equal(30 * time.Second))
+			Expect(cfg.Timeouts.AckWait).To(Equal(5 * time.Second))
 			Expect(cfg.Hasura.Endpoint).To(Equal("http://localhost:8080/v1/graphql"))
 			Expect(cfg.Hasura.Secret).To(Equal("aviation-test"))
+			Expect(cfg.Retry.Repository).To(Equal(RetryConfig{Attempts: 3, InitialInterval: 200 * time.Millisecond, MaxInterval: 2 * time.Second, Multiplier: 2}))
+		})
+	})
+
+	Context("Legacy keys", func() {
+		It("should read the queue group from the legacy queue key", func() {
+			v := viper.New()
+			v.SetConfigType("toml")
+			Expect(v.ReadConfig(strings.NewReader("[subscription]\nqueue = \"legacy-group\"\n"))).To(Succeed())
+			applyLegacyKeys(v)
+			var cfg Config
+			Expect(v.Unmarshal(&cfg)).To(Succeed())
+			Expect(cfg.Subscription.QueueGroup).To(Equal("legacy-group"))
+		})
+
+		It("should prefer the current key", func() {
+			v := viper.New()
+			v.SetConfigType("toml")
+			Expect(v.ReadConfig(strings.NewReader("[subscription]\nqueue = \"legacy-group\"\nqueue_group = \"group\"\n"))).To(Succeed())
+			applyLegacyKeys(v)
+			Expect(v.GetString("subscription.queue_group")).To(Equal("group"))
 		})
 	})
 
@@ -108,5 +139,82 @@ secret  = "aviation-test"
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(Equal("subscription topic is required"))
 		})
+
+		It("should return an error for an unknown JetStream ack policy", func() {
+			cfg := GetMyConfig()
+			cfg.JetStream = JetStreamConfig{Enabled: true, Stream: "TELEGRAMS", Durable: "caatsm", AckPolicy: "sometimes"}
+			err := ValidateConfig(cfg)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("invalid jetstream ack_policy: sometimes"))
+		})
+
+		It("should return an error when the retries outlast the ack wait", func() {
+			cfg := GetMyConfig()
+			cfg.JetStream = JetStreamConfig{Enabled: true, Stream: "TELEGRAMS", Durable: "caatsm"}
+			cfg.Timeouts.AckWait = time.Second
+			cfg.Retry.Repository = RetryConfig{Attempts: 5, InitialInterval: 200 * time.Millisecond, Multiplier: 2}
+			Expect(ValidateConfig(cfg)).To(MatchError(ContainSubstring("longer than ack_wait")))
+		})
+
+		It("should count the messages queued on a worker in the retry budget", func() {
+			cfg := GetMyConfig()
+			cfg.JetStream = JetStreamConfig{Enabled: true, Stream: "TELEGRAMS", Durable: "caatsm"}
+			cfg.Timeouts.AckWait = 5 * time.Second
+			cfg.Retry.Repository = RetryConfig{Attempts: 3, InitialInterval: 200 * time.Millisecond, Multiplier: 2}
+			Expect(ValidateConfig(cfg)).To(MatchError(ContainSubstring("longer than ack_wait")))
+			cfg.Timeouts.AckWait = 30 * time.Second
+			Expect(ValidateConfig(cfg)).To(Succeed())
+		})
+
+		It("should return an error for an invalid subject template", func() {
+			cfg := GetMyConfig()
+			cfg.Publisher.SubjectTemplate = "Telegram.Json.{flight}"
+			Expect(ValidateConfig(cfg)).To(MatchError(ContainSubstring("unknown placeholder {flight}")))
+		})
+
+		It("should return an error for an unknown CloudEvents mode", func() {
+			cfg := GetMyConfig()
+			cfg.Publisher.CloudEvents.Mode = "batched"
+			Expect(ValidateConfig(cfg)).To(MatchError("invalid cloudevents mode: batched"))
+		})
+
+		It("should return an error for an unknown publisher format", func() {
+			cfg := GetMyConfig()
+			cfg.Publisher.Format = "avro"
+			Expect(ValidateConfig(cfg)).To(MatchError("invalid publisher format: avro"))
+		})
+
+		It("should return an error for protobuf in structured CloudEvents", func() {
+			cfg := GetMyConfig()
+			cfg.Publisher.Format = "protobuf"
+			cfg.Publisher.CloudEvents.Mode = "binary"
+			Expect(ValidateConfig(cfg)).To(Succeed())
+			cfg.Publisher.CloudEvents.Mode = "structured"
+			Expect(ValidateConfig(cfg)).To(MatchError(ContainSubstring("use binary mode")))
+		})
+
+		It("should return an error for the file exporter without a file", func() {
+			cfg := GetMyConfig()
+			cfg.Tracing = TracingConfig{Exporter: "file"}
+			Expect(ValidateConfig(cfg)).To(MatchError("tracing file is required for the file exporter"))
+			cfg.Tracing.Exporter = "zipkin"
+			Expect(ValidateConfig(cfg)).To(MatchError("invalid tracing exporter: zipkin"))
+		})
+	})
+
+	Context("Retry backoff", func() {
+		policy := RetryConfig{Attempts: 5, InitialInterval: 100 * time.Millisecond, MaxInterval: 300 * time.Millisecond, Multiplier: 2}
+
+		It("should grow the interval up to the maximum", func() {
+			Expect(policy.Interval(1)).To(Equal(100 * time.Millisecond))
+			Expect(policy.Interval(2)).To(Equal(200 * time.Millisecond))
+			Expect(policy.Interval(3)).To(Equal(300 * time.Millisecond))
+			Expect(policy.Interval(4)).To(Equal(300 * time.Millisecond))
+		})
+
+		It("should add up the waits between the attempts", func() {
+			Expect(policy.Budget()).To(Equal(900 * time.Millisecond))
+			Expect(RetryConfig{Attempts: 1, InitialInterval: time.Second}.Budget()).To(BeZero())
+		})
 	})
 })
@@ -0,0 +1,80 @@
+package domain
+
+import "strings"
+
+// Airport holds the reference data of an airport
+type Airport struct {
+	ICAO      string  `json:"icao"`           // ICAO 四字代码: ICAO location indicator (e.g., 'ZBTJ').
+	IATA      string  `json:"iata,omitempty"` // IATA 三字代码: IATA airport code (e.g., 'TSN').
+	Name      string  `json:"name"`           // 名称: Airport name.
+	Country   string  `json:"country"`        // 国家: ISO 3166-1 alpha-2 country code (e.g., 'CN').
+	Latitude  float64 `json:"latitude"`       // 纬度: Latitude in decimal degrees.
+	Longitude float64 `json:"longitude"`      // 经度: Longitude in decimal degrees.
+	Elevation int     `json:"elevation"`      // 标高: Elevation in feet.
+	Timezone  string  `json:"timezone"`       // 时区: IANA time zone name (e.g., 'Asia/Shanghai').
+}
+
+// AirportCodes returns the ICAO location indicators referenced by a parsed body
+func AirportCodes(body interface{}) []string {
+	var codes []string
+	switch b := body.(type) {
+	case *ARR:
+		codes = []string{b.DepartureAirport, b.ArrivalAirport, b.AlternateAirport}
+	case *DEP:
+		codes = []string{b.DepartureAirport, b.Destination, b.AlternateAirport}
+	case *CNL:
+		codes = []string{b.DepartureAirport, b.DestinationAirport}
+	case *DLA:
+		codes = []string{b.DepartureAirport, b.ArrivalAirport}
+	case *CHG:
+		codes = []string{b.DepartureAirport, b.ArrivalAirport, b.AlternateAirport}
+	case *ALN:
+		codes = []string{b.DepartureAirport, b.ArrivalAirport}
+	case *FPL:
+		codes = append([]string{b.DepartureAirport, firstCode(b.DestinationAndTotalTime)}, strings.Fields(b.AlternateAirport)...)
+	case *CPL:
+		codes = append([]string{b.DepartureAirport, firstCode(b.DestinationAndTotalTime)}, strings.Fields(b.AlternateAirport)...)
+	}
+
+	var result []string
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		if len(code) == 4 && !seen[code] {
+			seen[code] = true
+			result = append(result, code)
+		}
+	}
+	return result
+}
+
+// RouteAirports returns the departure and arrival location indicators of a
+// parsed body, empty when the body has no route
+func RouteAirports(body interface{}) (string, string) {
+	switch b := body.(type) {
+	case *ARR:
+		return b.DepartureAirport, b.ArrivalAirport
+	case *DEP:
+		return b.DepartureAirport, b.Destination
+	case *CNL:
+		return b.DepartureAirport, b.DestinationAirport
+	case *DLA:
+		return b.DepartureAirport, b.ArrivalAirport
+	case *CHG:
+		return b.DepartureAirport, b.ArrivalAirport
+	case *ALN:
+		return b.DepartureAirport, b.ArrivalAirport
+	case *FPL:
+		return b.DepartureAirport, firstCode(b.DestinationAndTotalTime)
+	case *CPL:
+		return b.DepartureAirport, firstCode(b.DestinationAndTotalTime)
+	}
+	return "", ""
+}
+
+// firstCode returns the leading location indicator of a field such as 'ZBAA0153'
+func firstCode(field string) string {
+	if len(field) < 4 {
+		return ""
+	}
+	return field[:4]
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AirportCodes", func() {
+	It("should collect the airports of an ARR", func() {
+		arr := &ARR{DepartureAirport: "RKSI", ArrivalAirport: "ZBTJ"}
+		Expect(AirportCodes(arr)).To(Equal([]string{"RKSI", "ZBTJ"}))
+	})
+
+	It("should collect destination and alternates of an FPL", func() {
+		fpl := &FPL{
+			DepartureAirport:        "ZSSS",
+			DestinationAndTotalTime: "ZBAA0153",
+			AlternateAirport:        "ZBYN ZBTJ",
+		}
+		Expect(AirportCodes(fpl)).To(Equal([]string{"ZSSS", "ZBAA", "ZBYN", "ZBTJ"}))
+	})
+
+	It("should return nothing for an unknown body", func() {
+		Expect(AirportCodes(nil)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("RouteAirports", func() {
+	It("should return the route of an FPL", func() {
+		dep, arr := RouteAirports(&FPL{DepartureAirport: "ZSSS", DestinationAndTotalTime: "ZBAA0153"})
+		Expect(dep).To(Equal("ZSSS"))
+		Expect(arr).To(Equal("ZBAA"))
+	})
+
+	It("should return nothing for an unknown body", func() {
+		dep, arr := RouteAirports(nil)
+		Expect(dep + arr).To(BeEmpty())
+	})
+})
@@ -39,15 +39,15 @@ OtherInfo (其他信息): Optional field for any additional relevant information
 
 // ALN 电报体中的预警报文结构
 type ALN struct {
-	Category           string `json:"category"`              // 电报类别
-	AircraftID         string `json:"aircraft_id"`           // 航空器识别标志
-	SSRModeAndCode     string `json:"ssr_mode_and_code"`     // SSR 模式及编码
-	FlightRulesAndType string `json:"flight_rules_and_type"` // 飞行规则和类型
-	DepartureAirport   string `json:"departure_airport"`     // 起飞机场
-	DepartureTime      string `json:"departure_time"`        // 起飞时间
-	ArrivalAirport     string `json:"arrival_airport"`       // 到达机场
-	ArrivalTime        string `json:"arrival_time"`          // 到达时间
-	OtherInfo          string `json:"other_info,omitempty"`  // 其他信息 (optional)
+	Category           string `json:"category"`            // 电报类别
+	AircraftID         string `json:"aircraftId"`          // 航空器识别标志
+	SSRModeAndCode     string `json:"ssrModeAndCode"`      // SSR 模式及编码
+	FlightRulesAndType string `json:"flightRulesAndType"`  // 飞行规则和类型
+	DepartureAirport   string `json:"departureAirport"`    // 起飞机场
+	DepartureTime      string `json:"departureTime"`       // 起飞时间
+	ArrivalAirport     string `json:"arrivalAirport"`      // 到达机场
+	ArrivalTime        string `json:"arrivalTime"`         // 到达时间
+	OtherInfo          string `json:"otherInfo,omitempty"` // 其他信息 (optional)
 }
 
 // Validate validates the ALN struct fields
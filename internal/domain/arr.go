@@ -28,16 +28,16 @@ OtherInfo: Kept as it was for any other information (from Group 18).
 
 // ARR 电报体中的到达报文结构
 type ARR struct {
-	Category             string `json:"category"`               // 电报类别
-	AircraftID           string `json:"aircraft_id"`            // 航空器识别标志
-	SSRModeAndCode       string `json:"ssr_mode_and_code"`      // SSR 模式及编码（可选）
-	DepartureAirport     string `json:"departure_airport"`      // 起飞机场
-	DepartureTime        string `json:"departure_time"`         // 起飞时间
-	ArrivalAirport       string `json:"arrival_airport"`        // 到达机场
-	ArrivalTime          string `json:"arrival_time"`           // 到达时间
-	EstimatedElapsedTime string `json:"estimated_elapsed_time"` // 估计总耗时（可选）
-	AlternateAirport     string `json:"alternate_airport"`      // 目的地备降机场（可选）
-	OtherInfo            string `json:"other_info"`             // 其他信息（可选）
+	Category             string `json:"category"`             // 电报类别
+	AircraftID           string `json:"aircraftId"`           // 航空器识别标志
+	SSRModeAndCode       string `json:"ssrModeAndCode"`       // SSR 模式及编码（可选）
+	DepartureAirport     string `json:"departureAirport"`     // 起飞机场
+	DepartureTime        string `json:"departureTime"`        // 起飞时间
+	ArrivalAirport       string `json:"arrivalAirport"`       // 到达机场
+	ArrivalTime          string `json:"arrivalTime"`          // 到达时间
+	EstimatedElapsedTime string `json:"estimatedElapsedTime"` // 估计总耗时（可选）
+	AlternateAirport     string `json:"alternateAirport"`     // 目的地备降机场（可选）
+	OtherInfo            string `json:"otherInfo"`            // 其他信息（可选）
 }
 
 // Validate validates the ARR struct fields
@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // An aviation message typically contains various fields that are crucial for air traffic management and communication.
 //These fields include identifiers, date and time, priority indicators, addresses,
@@ -34,9 +37,9 @@ MessageID: "TMQ1324".
 DateTime: "150631".
 PriorityIndicator: "FF".
 PrimaryAddress: "ZBTJZPZX".
-SecondaryAddresses: ["150630", "ZBACZQZX"].
-Originator: "".
-OriginatorDateTime: "".
+SecondaryAddresses: [].
+Originator: "ZBACZQZX".
+OriginatorDateTime: "150630".
 Category: "".
 BodyAndFooter: "CALLSIGN/ABC123\nFPL/AB1234-AB\nROUTE/NOR1.DCT\nALTITUDE/35000FT\nSPEED/450KT\nPOSITION/N55W011\nEMG/N\nRPT/POS\nSUP/Additional info\nFLIGHTPLANID/FP1234\nFILTIME/150600\nORIIND/AB12\nSERINFO/Service info\nNAVINFO/NAV details".
 BodyData: nil.
@@ -72,9 +75,9 @@ MessageID: "XMP4567".
 DateTime: "120915".
 PriorityIndicator: "DD".
 PrimaryAddress: "KLAXZPZX".
-SecondaryAddresses: ["120914", "KSFOZQZX"].
-Originator: "".
-OriginatorDateTime: "".
+SecondaryAddresses: [].
+Originator: "KSFOZQZX".
+OriginatorDateTime: "120914".
 Category: "".
 BodyAndFooter: "CALLSIGN/DEF456\nFPL/CD5678-DC\nROUTE/NOR2.DCT\nALTITUDE/36000FT\nSPEED/500KT\nPOSITION/N54W012\nEMG/Y\nRPT/WX\nSUP/Weather related info\nFLIGHTPLANID/FP5678\nFILTIME/120900\nORIIND/CD34\nSERINFO/Service related info\nNAVINFO/Navigation details".
 BodyData: nil.
@@ -87,35 +90,57 @@ NeedDispatch: false.
 // ParsedMessage holds the parsed data from an aviation message
 type ParsedMessage struct {
 	// StartIndicator     string      `json:"startIndicator"`               // 电报开始标识: The start of the message indicator (e.g., 'ZCZC').
-	Uuid               string      `json:"uuid"`
-	MessageID          string      `json:"messageId"`                    // 信息ID: The message ID (e.g., 'TMQ1324').
-	DateTime           string      `json:"dateTime"`                     // 日期时间: The date and time of the message (e.g., '150631').
-	PriorityIndicator  string      `json:"priorityIndicator"`            // 优先级标识: The priority level of the message (e.g., 'FF').
-	PrimaryAddress     string      `json:"primaryAddress"`               // 主要地址: The primary recipient address (e.g., 'ZBTJZPZX').
-	SecondaryAddresses string      `json:"secondaryAddresses,omitempty"` // 次要地址: Additional recipient addresses (e.g., ['150630', 'ZBACZQZX']).
-	Originator         string      `json:"originator,omitempty"`         // 发件人: The sender of the message.
-	OriginatorDateTime string      `json:"originatorDateTime,omitempty"` // 发件日期时间: The date and time when the originator sent the message.
-	Category           string      `json:"category,omitempty"`           // 类别: The category of the message.
-	Body               string      // 正文和页脚: The body and footer of the message (e.g., 'CALLSIGN/ABC123\nFPL/AB1234-AB\n...').
-	Content            string      `json:"content,omitempty"`      // 正文: The body of the message.
-	BodyData           interface{} `json:"bodyData,omitempty"`     // 正文数据: Parsed body data.
-	ReceivedAt         time.Time   `json:"receivedAt"`             // 接收时间: The time when the message was received.
-	ParsedAt           time.Time   `json:"parsedAt,omitempty"`     // 解析时间: The time when the message was parsed.
-	DispatchedAt       time.Time   `json:"dispatchedAt,omitempty"` // 分发时间: The time when the message was dispatched.
-	NeedDispatch       bool        `json:"needDispatch"`           // 需要分发: Indicates if the message needs to be dispatched.
-	Parsed             bool        `json:"parsed"`                 // 解析: Indicates if the message has been parsed.
-	Comments           string      `json:"comments,omitempty"`     // 备注: Additional comments.
+	SchemaVersion      string            `json:"schemaVersion"` // 模式版本: Version of the JSON schema of the message (e.g., '1.0.0').
+	Uuid               string            `json:"uuid"`
+	MessageID          string            `json:"messageId"`                    // 信息ID: The message ID (e.g., 'TMQ1324').
+	DateTime           string            `json:"dateTime"`                     // 日期时间: The date and time of the message (e.g., '150631').
+	PriorityIndicator  string            `json:"priorityIndicator"`            // 优先级标识: The priority level of the message (e.g., 'FF').
+	PrimaryAddress     string            `json:"primaryAddress"`               // 主要地址: The primary recipient address (e.g., 'ZBTJZPZX').
+	SecondaryAddresses []string          `json:"secondaryAddresses,omitempty"` // 次要地址: Additional recipient addresses (e.g., ['ZBACZQZX', 'ZSSSZPZX']).
+	Originator         string            `json:"originator,omitempty"`         // 发件人: The sender of the message.
+	OriginatorDateTime string            `json:"originatorDateTime,omitempty"` // 发件日期时间: The date and time when the originator sent the message.
+	Category           string            `json:"category,omitempty"`           // 类别: The category of the message.
+	Body               string            `json:"body,omitempty"`               // 正文和页脚: The body and footer of the message (e.g., 'CALLSIGN/ABC123\nFPL/AB1234-AB\n...').
+	Content            string            `json:"content,omitempty"`            // 正文: The body of the message.
+	BodyData           interface{}       `json:"bodyData,omitempty"`           // 正文数据: Parsed body data.
+	Airports           []Airport         `json:"airports,omitempty"`           // 机场: Reference data of the airports in the body.
+	ReceivedAt         time.Time         `json:"receivedAt"`                   // 接收时间: The time when the message was received.
+	ParsedAt           time.Time         `json:"parsedAt,omitempty"`           // 解析时间: The time when the message was parsed.
+	DispatchedAt       time.Time         `json:"dispatchedAt,omitempty"`       // 分发时间: The time when the message was dispatched.
+	NeedDispatch       bool              `json:"needDispatch"`                 // 需要分发: Indicates if the message needs to be dispatched.
+	Parsed             bool              `json:"parsed"`                       // 解析: Indicates if the message has been parsed.
+	Comments           string            `json:"comments,omitempty"`           // 备注: Additional comments.
+	HeaderErrors       []HeaderError     `json:"headerErrors,omitempty"`       // 报头错误: Problems found while validating the header fields.
+	HeaderWarnings     []HeaderError     `json:"headerWarnings,omitempty"`     // 报头警告: Problems with the header fields that do not reject the message.
+	TraceContext       map[string]string `json:"traceContext,omitempty"`       // 追踪上下文: W3C trace context of the span that processed the message.
+}
+
+// HeaderError describes a header field that failed validation
+type HeaderError struct {
+	Field  string `json:"field"`  // 字段: The header field (e.g., 'priorityIndicator').
+	Value  string `json:"value"`  // 值: The offending raw value.
+	Reason string `json:"reason"` // 原因: Why the value was rejected.
+}
 
+func (e HeaderError) Error() string {
+	return fmt.Sprintf("invalid %s [%s]: %s", e.Field, e.Value, e.Reason)
 }
 
 // NewParsedMessage initializes a ParsedMessage with default values
 func NewParsedMessage() *ParsedMessage {
 	return &ParsedMessage{
-		// SecondaryAddresses: []string{},
-		Parsed: false,
+		SchemaVersion:      SchemaVersion,
+		SecondaryAddresses: []string{},
+		Parsed:             false,
 	}
 }
 
+// HeaderValid reports whether the priority, primary address and originator
+// passed validation; the header warnings do not count
+func (message *ParsedMessage) HeaderValid() bool {
+	return len(message.HeaderErrors) == 0
+}
+
 func (message *ParsedMessage) ToString() string {
 	return message.MessageID + " " + message.Category + " " + message.Originator
 }
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// CloudEvents content modes
+const (
+	CloudEventsStructured = "structured"
+	CloudEventsBinary     = "binary"
+)
+
+const (
+	CloudEventsSpecVersion = "1.0"
+	// CloudEventsContentType is the content type of a structured event
+	CloudEventsContentType = "application/cloudevents+json"
+	// DataContentType is the content type of the event data and of the bare messages
+	DataContentType = "application/json"
+)
+
+// CloudEvent is a CloudEvents 1.0 envelope in the JSON event format
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`          // 规范版本: Always '1.0'.
+	ID              string          `json:"id"`                   // 标识: The message uuid.
+	Source          string          `json:"source"`               // 来源: The originator address (e.g., '/aftn/ZSHCZTZX').
+	Type            string          `json:"type"`                 // 类型: The kind of message (e.g., 'caatsm.telegram.arr').
+	DataContentType string          `json:"datacontenttype"`      // 内容类型: Always 'application/json'.
+	DataSchema      string          `json:"dataschema,omitempty"` // 数据模式: The schema of the data.
+	Subject         string          `json:"subject,omitempty"`    // 主题: The telegram message id (e.g., 'TMQ2530').
+	Time            *time.Time      `json:"time,omitempty"`       // 时间: When the telegram was received.
+	Data            json.RawMessage `json:"data"`                 // 数据: The message.
+}
+
+// EventType returns the event type of a category, e.g. 'caatsm.telegram.arr'
+func EventType(prefix, category string) string {
+	if category == "" {
+		category = "unknown"
+	}
+	return prefix + "." + strings.ToLower(category)
+}
+
+// EventSource returns the event source of an originator address, e.g. '/aftn/ZSHCZTZX'
+func EventSource(prefix, originator string) string {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if originator == "" {
+		return prefix
+	}
+	return prefix + "/" + originator
+}
+
+// Headers returns the NATS headers of the event in binary mode, where the data
+// is the message body and each attribute is a 'ce-' header
+func (e *CloudEvent) Headers() map[string]string {
+	headers := map[string]string{
+		"ce-specversion": e.SpecVersion,
+		"ce-id":          e.ID,
+		"ce-source":      e.Source,
+		"ce-type":        e.Type,
+		"Content-Type":   e.DataContentType,
+	}
+	if e.DataSchema != "" {
+		headers["ce-dataschema"] = e.DataSchema
+	}
+	if e.Subject != "" {
+		headers["ce-subject"] = e.Subject
+	}
+	if e.Time != nil {
+		headers["ce-time"] = e.Time.UTC().Format(time.RFC3339Nano)
+	}
+	return headers
+}
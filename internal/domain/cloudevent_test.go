@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CloudEvent", func() {
+	It("should build the type and source", func() {
+		Expect(EventType("caatsm.telegram", "ARR")).To(Equal("caatsm.telegram.arr"))
+		Expect(EventType("caatsm.telegram", "")).To(Equal("caatsm.telegram.unknown"))
+		Expect(EventSource("/aftn/", "ZSHCZTZX")).To(Equal("/aftn/ZSHCZTZX"))
+		Expect(EventSource("/aftn", "")).To(Equal("/aftn"))
+	})
+
+	It("should map the attributes to binary mode headers", func() {
+		received := time.Date(2024, 10, 14, 16, 14, 0, 0, time.UTC)
+		event := &CloudEvent{
+			SpecVersion:     CloudEventsSpecVersion,
+			ID:              "id-1",
+			Source:          "/aftn/ZSHCZTZX",
+			Type:            "caatsm.telegram.arr",
+			DataContentType: DataContentType,
+			DataSchema:      "urn:caatsm:schema:parsed-message",
+			Time:            &received,
+		}
+		Expect(event.Headers()).To(Equal(map[string]string{
+			"ce-specversion": "1.0",
+			"ce-id":          "id-1",
+			"ce-source":      "/aftn/ZSHCZTZX",
+			"ce-type":        "caatsm.telegram.arr",
+			"ce-dataschema":  "urn:caatsm:schema:parsed-message",
+			"ce-time":        "2024-10-14T16:14:00Z",
+			"Content-Type":   "application/json",
+		}))
+	})
+})
@@ -4,11 +4,11 @@ import "fmt"
 
 // CNL represents the structure of a cancellation (CNL) message
 type CNL struct {
-	Category           string `json:"category"`             // 电报类别 (Message Category)
-	AircraftID         string `json:"aircraft_id"`          // 航空器识别标志 (Aircraft Identification)
-	DepartureAirport   string `json:"departure_airport"`    // 起飞机场 (Departure Airport)
-	DestinationAirport string `json:"destination_airport"`  // 到达机场 (Destination Airport)
-	OtherInfo          string `json:"other_info,omitempty"` // 其他信息 (optional) (Other Information)
+	Category           string `json:"category"`            // 电报类别 (Message Category)
+	AircraftID         string `json:"aircraftId"`          // 航空器识别标志 (Aircraft Identification)
+	DepartureAirport   string `json:"departureAirport"`    // 起飞机场 (Departure Airport)
+	DestinationAirport string `json:"destinationAirport"`  // 到达机场 (Destination Airport)
+	OtherInfo          string `json:"otherInfo,omitempty"` // 其他信息 (optional) (Other Information)
 }
 
 // Validate validates the CNL struct fields
@@ -79,18 +79,18 @@ Description: Optional field for any additional relevant information.
 
 // CPL 电报体中的航班计划变更报文结构
 type CPL struct {
-	Category                string `json:"category"`                    // 电报类别
-	AircraftID              string `json:"aircraft_id"`                 // 航空器识别标志
-	SSRModeAndCode          string `json:"ssr_mode_and_code"`           // SSR 模式及编码
-	FlightRulesAndType      string `json:"flight_rules_and_type"`       // 飞行规则和类型
-	AircraftAndEquipment    string `json:"aircraft_and_equipment"`      // 航机和设备
-	CruisingSpeedAndLevel   string `json:"cruising_speed_and_level"`    // 巡航速度和飞行高度
-	DepartureAirport        string `json:"departure_airport"`           // 起飞机场
-	DepartureTime           string `json:"departure_time"`              // 起飞时间
-	Route                   string `json:"route"`                       // 航路
-	DestinationAndTotalTime string `json:"destination_and_total_time"`  // 目的地机场和总时间
-	AlternateAirport        string `json:"alternate_airport,omitempty"` // 目的地备降机场 (optional)
-	OtherInfo               string `json:"other_info,omitempty"`        // 其他信息 (optional)
+	Category                string `json:"category"`                   // 电报类别
+	AircraftID              string `json:"aircraftId"`                 // 航空器识别标志
+	SSRModeAndCode          string `json:"ssrModeAndCode"`             // SSR 模式及编码
+	FlightRulesAndType      string `json:"flightRulesAndType"`         // 飞行规则和类型
+	AircraftAndEquipment    string `json:"aircraftAndEquipment"`       // 航机和设备
+	CruisingSpeedAndLevel   string `json:"cruisingSpeedAndLevel"`      // 巡航速度和飞行高度
+	DepartureAirport        string `json:"departureAirport"`           // 起飞机场
+	DepartureTime           string `json:"departureTime"`              // 起飞时间
+	Route                   string `json:"route"`                      // 航路
+	DestinationAndTotalTime string `json:"destinationAndTotalTime"`    // 目的地机场和总时间
+	AlternateAirport        string `json:"alternateAirport,omitempty"` // 目的地备降机场 (optional)
+	OtherInfo               string `json:"otherInfo,omitempty"`        // 其他信息 (optional)
 }
 
 // Validate validates the CPL struct fields
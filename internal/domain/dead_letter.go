@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// Stages at which a telegram can be rejected
+const (
+	StageParse       = "parse"
+	StageValidation  = "validation"
+	StagePersistence = "persistence"
+	StagePublishing  = "publishing"
+)
+
+// DeadLetter holds a telegram that could not be processed, with the reason it was rejected
+type DeadLetter struct {
+	Uuid     string            `json:"uuid"`              // 标识: The id of the received message.
+	Stage    string            `json:"stage"`             // 阶段: Where processing failed (parse, validation, persistence or publishing).
+	Reason   string            `json:"reason"`            // 原因: The error that rejected the message.
+	Attempts int               `json:"attempts"`          // 尝试次数: How many times the message was delivered.
+	Payload  string            `json:"payload"`           // 原文: The original telegram text, or the outbox payload at the publishing stage.
+	Topic    string            `json:"topic,omitempty"`   // 主题: The topic an outbox record was to be published on.
+	Headers  map[string]string `json:"headers,omitempty"` // 消息头: The headers of the outbox record.
+	FailedAt time.Time         `json:"failedAt"`          // 失败时间: When the message was dead-lettered.
+}
+
+// NewDeadLetter records a rejected telegram
+func NewDeadLetter(uuid string, payload []byte, stage, reason string, attempts int) *DeadLetter {
+	return &DeadLetter{
+		Uuid:     uuid,
+		Stage:    stage,
+		Reason:   reason,
+		Attempts: attempts,
+		Payload:  string(payload),
+		FailedAt: time.Now(),
+	}
+}
@@ -52,15 +52,15 @@ Description: This field contains any additional relevant information. It is opti
 */
 // DEP 电报体中的起飞报文结构
 type DEP struct {
-	Category             string `json:"category"`                    // 电报类别
-	AircraftID           string `json:"aircraft_id"`                 // 航空器识别标志
-	SSRModeAndCode       string `json:"ssr_mode_and_code,omitempty"` // SSR 模式及编码（可选）
-	DepartureAirport     string `json:"departure_airport"`           // 起飞机场
-	DepartureTime        string `json:"departure_time"`              // 起飞时间
-	Destination          string `json:"destination"`                 // 目的地机场
-	EstimatedElapsedTime string `json:"estimated_elapsed_time"`      // 估计总耗时
-	AlternateAirport     string `json:"alternate_airport,omitempty"` // 目的地备降机场（可选）
-	OtherInfo            string `json:"other_info,omitempty"`        // 其他信息（可选）
+	Category             string `json:"category"`                   // 电报类别
+	AircraftID           string `json:"aircraftId"`                 // 航空器识别标志
+	SSRModeAndCode       string `json:"ssrModeAndCode,omitempty"`   // SSR 模式及编码（可选）
+	DepartureAirport     string `json:"departureAirport"`           // 起飞机场
+	DepartureTime        string `json:"departureTime"`              // 起飞时间
+	Destination          string `json:"destination"`                // 目的地机场
+	EstimatedElapsedTime string `json:"estimatedElapsedTime"`       // 估计总耗时
+	AlternateAirport     string `json:"alternateAirport,omitempty"` // 目的地备降机场（可选）
+	OtherInfo            string `json:"otherInfo,omitempty"`        // 其他信息（可选）
 }
 
 // Validate validates the DEP struct fields
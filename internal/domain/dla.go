@@ -56,14 +56,14 @@ Description: Optional field for any additional relevant information.
 
 // DLA 电报体中的延误报文结构
 type DLA struct {
-	Category         string `json:"category"`                         // 电报类别
-	AircraftID       string `json:"aircraft_id"`                      // 航空器识别标志
-	SSRModeAndCode   string `json:"ssr_mode_and_code,omitempty"`      // SSR 模式及编码
-	DepartureAirport string `json:"departure_airport"`                // 起飞机场
-	NewDepartureTime string `json:"new_departure_time,omitempty"`     // 新的起飞时间
-	ArrivalAirport   string `json:"arrival_airport"`                  // 到达机场
-	ArrivalTime      string `json:"estimated_elapsed_time,omitempty"` // 估计总耗时
-	OtherInfo        string `json:"other_info,omitempty"`             // 其他信息 (optional)
+	Category         string `json:"category"`                       // 电报类别
+	AircraftID       string `json:"aircraftId"`                     // 航空器识别标志
+	SSRModeAndCode   string `json:"ssrModeAndCode,omitempty"`       // SSR 模式及编码
+	DepartureAirport string `json:"departureAirport"`               // 起飞机场
+	NewDepartureTime string `json:"newDepartureTime,omitempty"`     // 新的起飞时间
+	ArrivalAirport   string `json:"arrivalAirport"`                 // 到达机场
+	ArrivalTime      string `json:"estimatedElapsedTime,omitempty"` // 估计总耗时
+	OtherInfo        string `json:"otherInfo,omitempty"`            // 其他信息 (optional)
 }
 
 // Validate validates the DLA struct fields
@@ -0,0 +1,38 @@
+package domain
+
+// FlightNumbers returns the flight numbers of a parsed body: the aircraft
+// identification of an ATS message, the flight numbers of a schedule
+func FlightNumbers(body interface{}) []string {
+	switch b := body.(type) {
+	case *ARR:
+		return nonEmpty(b.AircraftID)
+	case *DEP:
+		return nonEmpty(b.AircraftID)
+	case *CNL:
+		return nonEmpty(b.AircraftID)
+	case *DLA:
+		return nonEmpty(b.AircraftID)
+	case *CHG:
+		return nonEmpty(b.AircraftID)
+	case *CPL:
+		return nonEmpty(b.AircraftID)
+	case *ALN:
+		return nonEmpty(b.AircraftID)
+	case *FPL:
+		return nonEmpty(b.FlightNumber)
+	case *Schedule:
+		var numbers []string
+		for _, line := range b.Lines {
+			numbers = append(numbers, line.FlightNumber...)
+		}
+		return numbers
+	}
+	return nil
+}
+
+func nonEmpty(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return []string{value}
+}
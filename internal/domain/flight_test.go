@@ -0,0 +1,26 @@
+package domain
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FlightNumbers", func() {
+	It("should return the aircraft identification of an ATS message", func() {
+		Expect(FlightNumbers(&DLA{AircraftID: "CES5470"})).To(Equal([]string{"CES5470"}))
+		Expect(FlightNumbers(&ARR{})).To(BeEmpty())
+	})
+
+	It("should return the flight number of an FPL", func() {
+		Expect(FlightNumbers(&FPL{FlightNumber: "JAE7433", AircraftID: "B744/H"})).To(Equal([]string{"JAE7433"}))
+	})
+
+	It("should return the flight numbers of every schedule line", func() {
+		schedule := &Schedule{Lines: []ScheduleLine{{FlightNumber: []string{"HU7205", "HU7206"}}, {FlightNumber: []string{"HU7301"}}}}
+		Expect(FlightNumbers(schedule)).To(Equal([]string{"HU7205", "HU7206", "HU7301"}))
+	})
+
+	It("should return nothing for an unknown body", func() {
+		Expect(FlightNumbers(nil)).To(BeEmpty())
+	})
+})
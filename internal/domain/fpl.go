@@ -54,29 +54,29 @@ Example message:
 
 // FPL represents the structure of a Flight Plan message in the FPL telegram body
 type FPL struct {
-	Category                string `json:"category"`                      // 电报类别: The category of the telegram (e.g., 'FPL' for Flight Plan).
-	FlightNumber            string `json:"flight_number"`                 // 航班号: The flight number (e.g., 'JAE7433').
-	ReferenceData           string `json:"reference_data,omitempty"`      // 参考数据（可选）: Reference data, if applicable.
-	AircraftID              string `json:"aircraft_id"`                   // 航空器识别标志: The aircraft identification (e.g., 'B744/H').
-	SSRModeAndCode          string `json:"ssr_mode_and_code"`             // SSR 模式及编码: The SSR mode and code (e.g., 'SXIRPZJWY/S').
-	FlightRulesAndType      string `json:"flight_rules_and_type"`         // 飞行规则和类型: Flight rules and type (e.g., 'IS').
-	CruisingSpeedAndLevel   string `json:"cruising_speed_and_level"`      // 巡航速度和飞行高度: Cruising speed and flight level (e.g., 'K0926S0920').
-	DepartureAirport        string `json:"departure_airport"`             // 起飞机场: Departure airport code (e.g., 'ZBTJ').
-	DepartureTime           string `json:"departure_time"`                // 起飞时间: Departure time (e.g., '1755').
-	Route                   string `json:"route"`                         // 航路: The flight route (e.g., 'CG A326 VYK W80 HUR ... GED2W').
-	DestinationAndTotalTime string `json:"destination_and_total_time"`    // 目的地机场和估计总耗时: Destination airport and estimated total time (e.g., 'EDDF0948').
-	AlternateAirport        string `json:"alternate_airport,omitempty"`   // 目的地备降机场（可选）: Alternate airport (e.g., 'EDDK').
-	OtherInfo               string `json:"other_info,omitempty"`          // 其他信息（可选）: Other information.
-	SupplementaryInfo       string `json:"supplementary_info,omitempty"`  // 补充信息（可选）: Supplementary information.
-	EstimatedArrivalTime    string `json:"estimated_arrival_time"`        // 预计到达时间: Estimated time of arrival (e.g., '0948').
-	PBN                     string `json:"pbn"`                           // 性能导航: Performance-based navigation equipment (e.g., 'A1B2B3B4B5D1L1').
-	NavigationEquipment     string `json:"navigation_equipment"`          // 导航设备: Navigation equipment (e.g., 'NAV/ABAS').
-	EstimatedElapsedTime    string `json:"estimated_elapsed_time"`        // 估计飞行时间: Estimated elapsed time (e.g., 'EET/ZMUB0100').
-	SELCALCode              string `json:"selcal_code"`                   // SELCAL代码: SELCAL code (e.g., 'JLAD').
-	Register                string `json:"register,omitempty"`            // 注册号（可选）: Aircraft registration number (e.g., 'B2422').
-	PerformanceCategory     string `json:"performance_category"`          // 性能类别: Aircraft performance category (e.g., 'C').
-	RerouteInformation      string `json:"reroute_information,omitempty"` // 重航信息（可选）: Reroute information (e.g., 'RIF/FRT N640 ZBYN').
-	Remarks                 string `json:"remarks,omitempty"`             // 备注（可选）: Remarks (e.g., 'RMK/TCAS EQUIPPED').
+	Category                string `json:"category"`                     // 电报类别: The category of the telegram (e.g., 'FPL' for Flight Plan).
+	FlightNumber            string `json:"flightNumber"`                 // 航班号: The flight number (e.g., 'JAE7433').
+	ReferenceData           string `json:"referenceData,omitempty"`      // 参考数据（可选）: Reference data, if applicable.
+	AircraftID              string `json:"aircraftId"`                   // 航空器识别标志: The aircraft identification (e.g., 'B744/H').
+	SSRModeAndCode          string `json:"ssrModeAndCode"`               // SSR 模式及编码: The SSR mode and code (e.g., 'SXIRPZJWY/S').
+	FlightRulesAndType      string `json:"flightRulesAndType"`           // 飞行规则和类型: Flight rules and type (e.g., 'IS').
+	CruisingSpeedAndLevel   string `json:"cruisingSpeedAndLevel"`        // 巡航速度和飞行高度: Cruising speed and flight level (e.g., 'K0926S0920').
+	DepartureAirport        string `json:"departureAirport"`             // 起飞机场: Departure airport code (e.g., 'ZBTJ').
+	DepartureTime           string `json:"departureTime"`                // 起飞时间: Departure time (e.g., '1755').
+	Route                   string `json:"route"`                        // 航路: The flight route (e.g., 'CG A326 VYK W80 HUR ... GED2W').
+	DestinationAndTotalTime string `json:"destinationAndTotalTime"`      // 目的地机场和估计总耗时: Destination airport and estimated total time (e.g., 'EDDF0948').
+	AlternateAirport        string `json:"alternateAirport,omitempty"`   // 目的地备降机场（可选）: Alternate airport (e.g., 'EDDK').
+	OtherInfo               string `json:"otherInfo,omitempty"`          // 其他信息（可选）: Other information.
+	SupplementaryInfo       string `json:"supplementaryInfo,omitempty"`  // 补充信息（可选）: Supplementary information.
+	EstimatedArrivalTime    string `json:"estimatedArrivalTime"`         // 预计到达时间: Estimated time of arrival (e.g., '0948').
+	PBN                     string `json:"pbn"`                          // 性能导航: Performance-based navigation equipment (e.g., 'A1B2B3B4B5D1L1').
+	NavigationEquipment     string `json:"navigationEquipment"`          // 导航设备: Navigation equipment (e.g., 'NAV/ABAS').
+	EstimatedElapsedTime    string `json:"estimatedElapsedTime"`         // 估计飞行时间: Estimated elapsed time (e.g., 'EET/ZMUB0100').
+	SELCALCode              string `json:"selcalCode"`                   // SELCAL代码: SELCAL code (e.g., 'JLAD').
+	Register                string `json:"register,omitempty"`           // 注册号（可选）: Aircraft registration number (e.g., 'B2422').
+	PerformanceCategory     string `json:"performanceCategory"`          // 性能类别: Aircraft performance category (e.g., 'C').
+	RerouteInformation      string `json:"rerouteInformation,omitempty"` // 重航信息（可选）: Reroute information (e.g., 'RIF/FRT N640 ZBYN').
+	Remarks                 string `json:"remarks,omitempty"`            // 备注（可选）: Remarks (e.g., 'RMK/TCAS EQUIPPED').
 }
 
 // Validate validates the FPL struct fields
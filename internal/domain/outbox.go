@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxRecord holds a message to publish, saved together with the telegram it comes from
+type OutboxRecord struct {
+	Uuid         string            `json:"uuid"`                   // 标识: The id of the record, also used as the NATS message id.
+	TelegramUuid string            `json:"telegramUuid"`           // 电报标识: The telegram the message was built from.
+	Topic        string            `json:"topic"`                  // 主题: Where the message is published.
+	Payload      json.RawMessage   `json:"payload"`                // 内容: The message as published.
+	Headers      map[string]string `json:"headers,omitempty"`      // 消息头: NATS headers published with the message.
+	TraceContext map[string]string `json:"traceContext,omitempty"` // 追踪上下文: W3C trace context of the span that saved the record.
+	Attempts     int               `json:"attempts,omitempty"`     // 尝试次数: Failed attempts to publish the record so far.
+	CreatedAt    time.Time         `json:"createdAt"`              // 创建时间: When the record was saved.
+}
+
+// NewOutboxRecord records a message to publish on the topic. The record uuid is
+// derived from the telegram uuid and the topic, so a telegram saved again does
+// not add a second record.
+func NewOutboxRecord(telegramUuid, topic string, message interface{}) (*OutboxRecord, error) {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxRecord{
+		Uuid:         uuid.NewSHA1(uuid.NameSpaceURL, []byte(telegramUuid+"/"+topic)).String(),
+		TelegramUuid: telegramUuid,
+		Topic:        topic,
+		Payload:      payload,
+		CreatedAt:    time.Now(),
+	}, nil
+}
@@ -1,6 +1,9 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type ScheduleLine struct {
 	Index string `json:"index,omitempty"`
@@ -15,15 +18,15 @@ type ScheduleLine struct {
 
 	// Flight number of the flight schedule. This is a unique identifier for the flight.
 	// Example: "CA1014"
-	FlightNumber []string `json:"flight_number"`
+	FlightNumber []string `json:"flightNumber"`
 
 	// Aircraft registration number. This is a unique identifier for the aircraft used for the flight.
 	// Example: "B2458"
-	AircraftReg string `json:"aircraft_reg"`
+	AircraftReg string `json:"aircraftReg"`
 
 	// Passenger configuration or seating arrangement for the flight. This field may include details about class configurations.
 	// Example: "1/1"
-	PassengerConfig string `json:"passenger_config,omitempty"`
+	PassengerConfig string `json:"passengerConfig,omitempty"`
 
 	// Instrument Landing System (ILS) category or configuration used for the flight. This field may include ILS category details.
 	// Example: "ILS(0)"
@@ -40,9 +43,35 @@ type ScheduleLine struct {
 }
 
 type WayPoint struct {
-	ArrivalTime   string
-	Airport       string
-	DepartureTime string
+	// Local arrival time, without the date suffix.
+	// Example: "1845"
+	ArrivalTime string `json:"arrivalTime,omitempty"`
+
+	// Local arrival date taken from the "(11JUN)" suffix, empty when the line date applies.
+	// Example: "11JUN"
+	ArrivalDate string `json:"arrivalDate,omitempty"`
+
+	// Arrival time converted to UTC with the airport's time zone, nil when the date or zone is unknown.
+	ArrivalUTC *time.Time `json:"arrivalUtc,omitempty"`
+
+	// IATA airport code as written in the schedule.
+	// Example: "TSN"
+	Airport string `json:"airport"`
+
+	// ICAO location indicator mapped from the IATA code, empty if unknown.
+	// Example: "ZBTJ"
+	AirportICAO string `json:"airportIcao,omitempty"`
+
+	// Local departure time, without the date suffix.
+	// Example: "2100"
+	DepartureTime string `json:"departureTime,omitempty"`
+
+	// Local departure date taken from the "(30OCT)" suffix, empty when the line date applies.
+	// Example: "30OCT"
+	DepartureDate string `json:"departureDate,omitempty"`
+
+	// Departure time converted to UTC with the airport's time zone, nil when the date or zone is unknown.
+	DepartureUTC *time.Time `json:"departureUtc,omitempty"`
 }
 
 func (f *ScheduleLine) Validate() error {
@@ -57,3 +86,54 @@ func (f *ScheduleLine) Validate() error {
 	}
 	return nil
 }
+
+// Status of a line in a schedule document
+const (
+	LineParsed    = "parsed"
+	LineCancelled = "cancelled"
+	LineFailed    = "failed"
+	LineSkipped   = "skipped"
+)
+
+// Schedule is a whole schedule telegram broken down into its flight lines
+type Schedule struct {
+	// Airline the schedule was published by, detected from the header or the flight numbers.
+	// Example: "HU"
+	Airline string `json:"airline"`
+
+	// Date the schedule applies to, taken from the header.
+	// Example: "31OCT"
+	Date string `json:"date,omitempty"`
+
+	// Title lines found before the first flight line.
+	Title string `json:"title,omitempty"`
+
+	Lines []ScheduleLine `json:"lines"`
+
+	// Diagnostics for every line that was not parsed cleanly.
+	Diagnostics []LineDiagnostic `json:"diagnostics,omitempty"`
+
+	Summary ScheduleSummary `json:"summary"`
+}
+
+// LineDiagnostic reports the outcome of a single line of a schedule document
+type LineDiagnostic struct {
+	// Line number in the document, starting at 1.
+	LineNumber int `json:"lineNumber"`
+
+	// Status of the line: parsed, cancelled, failed or skipped.
+	Status string `json:"status"`
+
+	Message string `json:"message,omitempty"`
+
+	Text string `json:"text"`
+}
+
+// ScheduleSummary counts the lines of a schedule document by outcome
+type ScheduleSummary struct {
+	Total     int `json:"total"`
+	Parsed    int `json:"parsed"`
+	Cancelled int `json:"cancelled"`
+	Failed    int `json:"failed"`
+	Skipped   int `json:"skipped"`
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// SchemaVersion is the version of the JSON schemas of the published messages.
+// It changes with the fields of ParsedMessage, Schedule or a body type. The
+// JSON fields of all of them are camelCase, as in the protobuf JSON mapping.
+const SchemaVersion = "1.0.0"
+
+// Schema names of the published messages, appended to the dataschema prefix
+const (
+	SchemaParsedMessage = "parsed-message"
+	SchemaSchedule      = "schedule"
+)
+
+// BodyTypes maps each category to the type of its BodyData
+var BodyTypes = map[string]interface{}{
+	"ARR":      ARR{},
+	"DEP":      DEP{},
+	"CNL":      CNL{},
+	"DLA":      DLA{},
+	"CHG":      CHG{},
+	"FPL":      FPL{},
+	"CPL":      CPL{},
+	"ALN":      ALN{},
+	"SCHEDULE": Schedule{},
+}
+
+// DecodeParsedMessage reads a parsed message from its JSON encoding, with the
+// body data as the type of its category
+func DecodeParsedMessage(data []byte) (*ParsedMessage, error) {
+	var message struct {
+		ParsedMessage
+		BodyData json.RawMessage `json:"bodyData,omitempty"`
+	}
+	if err := json.Unmarshal(data, &message); err != nil {
+		return nil, err
+	}
+	parsed := message.ParsedMessage
+	body, ok := BodyTypes[parsed.Category]
+	if !ok || len(message.BodyData) == 0 || string(message.BodyData) == "null" {
+		return &parsed, nil
+	}
+	bodyData := reflect.New(reflect.TypeOf(body)).Interface()
+	if err := json.Unmarshal(message.BodyData, bodyData); err != nil {
+		return nil, fmt.Errorf("invalid %s body: %v", parsed.Category, err)
+	}
+	parsed.BodyData = bodyData
+	return &parsed, nil
+}
+
+// SchemaID returns the id of the current version of a schema, e.g.
+// 'urn:caatsm:schema:parsed-message/1.0.0'
+func SchemaID(prefix, name string) string {
+	return prefix + name + "/" + SchemaVersion
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"reflect"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DecodeParsedMessage", func() {
+	It("should decode the body data as the type of the category", func() {
+		message, err := DecodeParsedMessage([]byte(`{"uuid":"telegram-1","category":"DEP","parsed":true,"bodyData":{"category":"DEP","aircraftId":"CES5470"}}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(message.Uuid).To(Equal("telegram-1"))
+		Expect(message.BodyData).To(Equal(&DEP{Category: "DEP", AircraftID: "CES5470"}))
+	})
+
+	It("should leave the body data empty for an unknown category", func() {
+		message, err := DecodeParsedMessage([]byte(`{"category":"XXX","bodyData":{"category":"XXX"}}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(message.BodyData).To(BeNil())
+	})
+
+	It("should return an error for an invalid body", func() {
+		_, err := DecodeParsedMessage([]byte(`{"category":"ARR","bodyData":"ARR"}`))
+		Expect(err).To(MatchError(ContainSubstring("invalid ARR body")))
+	})
+})
+
+var _ = Describe("JSON fields", func() {
+	It("should be camelCase in every published type", func() {
+		types := []reflect.Type{reflect.TypeOf(ParsedMessage{}), reflect.TypeOf(ScheduleLine{}), reflect.TypeOf(WayPoint{}),
+			reflect.TypeOf(LineDiagnostic{}), reflect.TypeOf(ScheduleSummary{})}
+		for _, body := range BodyTypes {
+			types = append(types, reflect.TypeOf(body))
+		}
+		for _, t := range types {
+			for i := 0; i < t.NumField(); i++ {
+				name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+				Expect(name).NotTo(ContainSubstring("_"), "%s.%s", t.Name(), t.Field(i).Name)
+			}
+		}
+	})
+})
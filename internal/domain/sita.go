@@ -66,20 +66,20 @@ NNNN
 
 // SITA defines the structure of a SITA telegram
 type SITA struct {
-	Header            SITAHeader     `json:"header"`          // Header information of the telegram
-	PriorityAndSender PrioritySender `json:"priority_sender"` // Priority and sender information
-	TimeAndReceiver   TimeReceiver   `json:"time_receiver"`   // Time and receiver information
-	Text              string         `json:"text"`            // Content of the telegram
-	ReceivedTime      time.Time      `json:"received_time"`   // Time the telegram was received
-	Category          string         `json:"category"`        // Category of the telegram
-	BodyData          interface{}    `json:"body_data"`       // Additional body data
+	Header            SITAHeader     `json:"header"`         // Header information of the telegram
+	PriorityAndSender PrioritySender `json:"prioritySender"` // Priority and sender information
+	TimeAndReceiver   TimeReceiver   `json:"timeReceiver"`   // Time and receiver information
+	Text              string         `json:"text"`           // Content of the telegram
+	ReceivedTime      time.Time      `json:"receivedTime"`   // Time the telegram was received
+	Category          string         `json:"category"`       // Category of the telegram
+	BodyData          interface{}    `json:"bodyData"`       // Additional body data
 }
 
 // SITAHeader defines the header of a SITA telegram
 type SITAHeader struct {
-	StartSignal string `json:"start_signal"` // Start signal indicating the beginning of the telegram
-	SendID      string `json:"send_id"`      // Sending ID uniquely identifying the telegram
-	SendTime    string `json:"send_time"`    // Sending time in the format DDHHMM
+	StartSignal string `json:"startSignal"` // Start signal indicating the beginning of the telegram
+	SendID      string `json:"sendId"`      // Sending ID uniquely identifying the telegram
+	SendTime    string `json:"sendTime"`    // Sending time in the format DDHHMM
 }
 
 // PrioritySender defines priority and sender address
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultSubjectFallback replaces a placeholder that has no value for a message
+const DefaultSubjectFallback = "UNKNOWN"
+
+// SubjectFields are the placeholders of a subject template
+var SubjectFields = []string{"category", "originator", "priority", "address", "dep", "arr", "airline"}
+
+var placeholderPattern = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// SubjectTemplate renders the NATS subject of a parsed message from a template
+// such as 'Telegram.Json.{category}.{originator}.{dep}'. A placeholder without
+// a value, e.g. the airports of an unparsed message, is rendered as the
+// fallback, so each message still has one token per placeholder.
+type SubjectTemplate struct {
+	template string
+	fallback string
+}
+
+// ParseSubjectTemplate checks the placeholders of a template. The fallback
+// defaults to DefaultSubjectFallback.
+func ParseSubjectTemplate(template, fallback string) (*SubjectTemplate, error) {
+	if fallback == "" {
+		fallback = DefaultSubjectFallback
+	}
+	if !validToken(fallback) {
+		return nil, fmt.Errorf("invalid subject fallback: %s", fallback)
+	}
+	for _, token := range strings.Split(template, ".") {
+		if token == "" {
+			return nil, fmt.Errorf("invalid subject template %s: empty token", template)
+		}
+		for _, match := range placeholderPattern.FindAllStringSubmatch(token, -1) {
+			if !knownField(match[1]) {
+				return nil, fmt.Errorf("invalid subject template %s: unknown placeholder {%s}", template, match[1])
+			}
+		}
+		if rest := placeholderPattern.ReplaceAllString(token, ""); strings.ContainsAny(rest, "{}*> \t") {
+			return nil, fmt.Errorf("invalid subject template %s: invalid token %s", template, token)
+		}
+	}
+	return &SubjectTemplate{template: template, fallback: fallback}, nil
+}
+
+// Render returns the subject of a message
+func (t *SubjectTemplate) Render(message *ParsedMessage) string {
+	fields := subjectFields(message)
+	return placeholderPattern.ReplaceAllStringFunc(t.template, func(placeholder string) string {
+		value := subjectToken(fields[placeholder[1:len(placeholder)-1]])
+		if value == "" {
+			return t.fallback
+		}
+		return value
+	})
+}
+
+// Wildcard returns the subject matching every rendered subject, with each token
+// holding a placeholder replaced by '*'
+func (t *SubjectTemplate) Wildcard() string {
+	tokens := strings.Split(t.template, ".")
+	for i, token := range tokens {
+		if placeholderPattern.MatchString(token) {
+			tokens[i] = "*"
+		}
+	}
+	return strings.Join(tokens, ".")
+}
+
+func (t *SubjectTemplate) String() string {
+	return t.template
+}
+
+// subjectFields returns the values of the placeholders for a message
+func subjectFields(message *ParsedMessage) map[string]string {
+	fields := map[string]string{
+		"originator": message.Originator,
+		"priority":   message.PriorityIndicator,
+		"address":    message.PrimaryAddress,
+	}
+	if message.Parsed {
+		fields["category"] = message.Category
+	}
+	switch body := message.BodyData.(type) {
+	case *Schedule:
+		fields["airline"] = body.Airline
+	default:
+		fields["dep"], fields["arr"] = RouteAirports(body)
+	}
+	return fields
+}
+
+// subjectToken makes a value a single subject token, replacing the characters
+// NATS reserves for separators and wildcards
+func subjectToken(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '*', '>', ' ', '\t', '\r', '\n':
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(value))
+}
+
+func validToken(token string) bool {
+	return token != "" && subjectToken(token) == token
+}
+
+func knownField(name string) bool {
+	for _, field := range SubjectFields {
+		if field == name {
+			return true
+		}
+	}
+	return false
+}
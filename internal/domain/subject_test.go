@@ -0,0 +1,52 @@
+package domain
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SubjectTemplate", func() {
+	It("should render the fields of a parsed message", func() {
+		template, err := ParseSubjectTemplate("Telegram.Json.{category}.{originator}.{dep}", "")
+		Expect(err).NotTo(HaveOccurred())
+		message := &ParsedMessage{
+			Category:   "ARR",
+			Originator: "ZSHCZTZX",
+			Parsed:     true,
+			BodyData:   &ARR{DepartureAirport: "ZBTJ", ArrivalAirport: "ZSHC"},
+		}
+		Expect(template.Render(message)).To(Equal("Telegram.Json.ARR.ZSHCZTZX.ZBTJ"))
+		Expect(template.Wildcard()).To(Equal("Telegram.Json.*.*.*"))
+	})
+
+	It("should fall back for the fields an unparsed message lacks", func() {
+		template, err := ParseSubjectTemplate("Telegram.Json.{category}.{dep}-{arr}", "NONE")
+		Expect(err).NotTo(HaveOccurred())
+		message := &ParsedMessage{Category: "ARR", Originator: "ZSHCZTZX"}
+		Expect(template.Render(message)).To(Equal("Telegram.Json.NONE.NONE-NONE"))
+	})
+
+	It("should render the airline of a schedule", func() {
+		template, err := ParseSubjectTemplate("Telegram.Schedule.{airline}.{dep}", "")
+		Expect(err).NotTo(HaveOccurred())
+		message := &ParsedMessage{Category: "SCHEDULE", Parsed: true, BodyData: &Schedule{Airline: "HU"}}
+		Expect(template.Render(message)).To(Equal("Telegram.Schedule.HU.UNKNOWN"))
+	})
+
+	It("should keep each value in a single token", func() {
+		template, err := ParseSubjectTemplate("Telegram.{originator}", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(template.Render(&ParsedMessage{Originator: "ZS.HC *>"})).To(Equal("Telegram.ZS_HC___"))
+	})
+
+	It("should reject unknown placeholders and invalid tokens", func() {
+		_, err := ParseSubjectTemplate("Telegram.{flight}", "")
+		Expect(err).To(MatchError(ContainSubstring("unknown placeholder {flight}")))
+		_, err = ParseSubjectTemplate("Telegram..{category}", "")
+		Expect(err).To(MatchError(ContainSubstring("empty token")))
+		_, err = ParseSubjectTemplate("Telegram.*.{category}", "")
+		Expect(err).To(MatchError(ContainSubstring("invalid token *")))
+		_, err = ParseSubjectTemplate("Telegram.{category}", "A.B")
+		Expect(err).To(MatchError("invalid subject fallback: A.B"))
+	})
+})
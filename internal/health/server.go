@@ -0,0 +1,152 @@
+package health
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/iface"
+	"caatsm/pkg/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Status is the body of the health and readiness endpoints
+type Status struct {
+	Status        string     `json:"status"`                  // 状态: up when the endpoint check passed.
+	Nats          string     `json:"nats"`                    // NATS: up when connected and taking messages.
+	Hasura        string     `json:"hasura,omitempty"`        // Hasura: up when reachable, only checked for readiness.
+	HasuraError   string     `json:"hasuraError,omitempty"`   // Hasura错误: Why Hasura could not be reached.
+	LastMessageAt *time.Time `json:"lastMessageAt,omitempty"` // 最后处理时间: When the last message was handled.
+	Backlog       int        `json:"backlog"`                 // 积压: Messages queued or being handled by the workers.
+	BacklogSince  *time.Time `json:"backlogSince,omitempty"`  // 积压开始时间: When messages started waiting, while there is a backlog.
+}
+
+// Server serves /healthz, which fails when the workers are stalled, and
+// /readyz, which fails unless NATS is connected and Hasura is reachable.
+type Server struct {
+	config     *config.Config
+	subscriber iface.SubscriberStatus
+	repository iface.RepositoryStatus
+	mux        *http.ServeMux
+	server     *http.Server
+}
+
+func NewServer(config *config.Config, subscriber iface.SubscriberStatus, repository iface.RepositoryStatus) *Server {
+	s := &Server{
+		config:     config,
+		subscriber: subscriber,
+		repository: repository,
+		mux:        http.NewServeMux(),
+	}
+	s.mux.HandleFunc("/healthz", s.healthz)
+	s.mux.HandleFunc("/readyz", s.readyz)
+	return s
+}
+
+// Handle adds an endpoint to the server
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+// Handler returns the handler of all the endpoints
+func (s *Server) Handler() http.Handler {
+	return s.mux
+}
+
+// Start serves the endpoints on the configured address until Shutdown is called.
+// It does nothing when no address is configured.
+func (s *Server) Start() {
+	if s.config.Health.Address == "" {
+		return
+	}
+	s.server = &http.Server{Addr: s.config.Health.Address, Handler: s.mux}
+	go func() {
+		utils.GetSugaredLogger().Infof("Serving health and metrics endpoints on %s", s.config.Health.Address)
+		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			utils.GetSugaredLogger().Errorf("Health server stopped: %v", err)
+		}
+	}()
+}
+
+// Shutdown stops the server, waiting for the requests being served
+func (s *Server) Shutdown(ctx context.Context) error {
+	if s.server == nil {
+		return nil
+	}
+	return s.server.Shutdown(ctx)
+}
+
+func (s *Server) healthz(w http.ResponseWriter, r *http.Request) {
+	status := s.report()
+	status.Status = StatusUp
+	if s.stalled(status) {
+		status.Status = StatusDown
+	}
+	write(w, status)
+}
+
+func (s *Server) readyz(w http.ResponseWriter, r *http.Request) {
+	status := s.report()
+	status.Hasura = StatusUp
+	timeout := s.config.Health.CheckTimeout
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+	if err := s.repository.Ping(ctx); err != nil {
+		status.Hasura = StatusDown
+		status.HasuraError = err.Error()
+	}
+	status.Status = StatusUp
+	if status.Nats != StatusUp || status.Hasura != StatusUp {
+		status.Status = StatusDown
+	}
+	write(w, status)
+}
+
+func (s *Server) report() Status {
+	status := Status{Nats: StatusDown, Backlog: s.subscriber.Backlog()}
+	if s.subscriber.Connected() {
+		status.Nats = StatusUp
+	}
+	if last := s.subscriber.LastHandled(); !last.IsZero() {
+		status.LastMessageAt = &last
+	}
+	if since := s.subscriber.BacklogSince(); status.Backlog > 0 && !since.IsZero() {
+		status.BacklogSince = &since
+	}
+	return status
+}
+
+// stalled reports messages waiting while none was handled within the stall
+// timeout, counted from the last message handled or from when messages started
+// waiting, whichever is later, so the first messages can stall too
+func (s *Server) stalled(status Status) bool {
+	timeout := s.config.Health.StallTimeout
+	if timeout <= 0 || status.Backlog == 0 {
+		return false
+	}
+	var since time.Time
+	if status.BacklogSince != nil {
+		since = *status.BacklogSince
+	}
+	if status.LastMessageAt != nil && status.LastMessageAt.After(since) {
+		since = *status.LastMessageAt
+	}
+	return !since.IsZero() && time.Since(since) > timeout
+}
+
+func write(w http.ResponseWriter, status Status) {
+	w.Header().Set("Content-Type", "application/json")
+	if status.Status != StatusUp {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(status)
+}
@@ -0,0 +1,110 @@
+package health
+
+import (
+	"caatsm/internal/config"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type fakeSubscriber struct {
+	connected    bool
+	lastHandled  time.Time
+	backlogSince time.Time
+	backlog      int
+}
+
+func (s *fakeSubscriber) Connected() bool         { return s.connected }
+func (s *fakeSubscriber) LastHandled() time.Time  { return s.lastHandled }
+func (s *fakeSubscriber) BacklogSince() time.Time { return s.backlogSince }
+func (s *fakeSubscriber) Backlog() int            { return s.backlog }
+
+type fakeRepository struct {
+	err error
+}
+
+func (r *fakeRepository) Ping(ctx context.Context) error { return r.err }
+
+var _ = Describe("Server", func() {
+	var (
+		subscriber *fakeSubscriber
+		repository *fakeRepository
+		server     *Server
+	)
+
+	get := func(path string) (int, Status) {
+		recorder := httptest.NewRecorder()
+		server.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		var status Status
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &status)).To(Succeed())
+		return recorder.Code, status
+	}
+
+	BeforeEach(func() {
+		subscriber = &fakeSubscriber{connected: true, lastHandled: time.Now(), backlog: 2}
+		repository = &fakeRepository{}
+		cfg := &config.Config{Health: config.HealthConfig{StallTimeout: time.Minute}}
+		server = NewServer(cfg, subscriber, repository)
+	})
+
+	It("should be ready when NATS and Hasura are up", func() {
+		code, status := get("/readyz")
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(status.Status).To(Equal(StatusUp))
+		Expect(status.Nats).To(Equal(StatusUp))
+		Expect(status.Hasura).To(Equal(StatusUp))
+		Expect(status.Backlog).To(Equal(2))
+		Expect(status.LastMessageAt).NotTo(BeNil())
+	})
+
+	It("should not be ready when Hasura cannot be reached", func() {
+		repository.err = errors.New("connection refused")
+		code, status := get("/readyz")
+		Expect(code).To(Equal(http.StatusServiceUnavailable))
+		Expect(status.Hasura).To(Equal(StatusDown))
+		Expect(status.HasuraError).To(Equal("connection refused"))
+	})
+
+	It("should not be ready when NATS is disconnected, but stay healthy", func() {
+		subscriber.connected = false
+		code, status := get("/readyz")
+		Expect(code).To(Equal(http.StatusServiceUnavailable))
+		Expect(status.Nats).To(Equal(StatusDown))
+
+		code, _ = get("/healthz")
+		Expect(code).To(Equal(http.StatusOK))
+	})
+
+	It("should be unhealthy when messages wait and none was handled within the stall timeout", func() {
+		subscriber.lastHandled = time.Now().Add(-2 * time.Minute)
+		subscriber.backlogSince = time.Now().Add(-3 * time.Minute)
+		code, status := get("/healthz")
+		Expect(code).To(Equal(http.StatusServiceUnavailable))
+		Expect(status.Status).To(Equal(StatusDown))
+
+		subscriber.backlog = 0
+		code, _ = get("/healthz")
+		Expect(code).To(Equal(http.StatusOK))
+	})
+	It("should be unhealthy when the first messages stall", func() {
+		subscriber.lastHandled = time.Time{}
+		subscriber.backlogSince = time.Now().Add(-2 * time.Minute)
+		code, status := get("/healthz")
+		Expect(code).To(Equal(http.StatusServiceUnavailable))
+		Expect(status.LastMessageAt).To(BeNil())
+		Expect(status.BacklogSince).NotTo(BeNil())
+	})
+
+	It("should count the stall from when messages started waiting again", func() {
+		subscriber.lastHandled = time.Now().Add(-2 * time.Minute)
+		subscriber.backlogSince = time.Now().Add(-time.Second)
+		code, _ := get("/healthz")
+		Expect(code).To(Equal(http.StatusOK))
+	})
+})
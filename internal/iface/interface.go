@@ -3,14 +3,24 @@ package iface
 import (
 	"caatsm/internal/config"
 	"caatsm/internal/domain"
+	"context"
+	"time"
 )
 
 type MessageHandler interface {
-	HandleMessage(msg []byte, id string) error
+	HandleMessage(ctx context.Context, msg []byte, id string) error
+}
+
+// MessageListener is told of each telegram parsed and saved
+type MessageListener interface {
+	OnMessage(message *domain.ParsedMessage)
 }
 
 type MessagePublisher interface {
 	Publish(message interface{}) error
+	PublishTo(topic string, message interface{}) error
+	PublishWithID(ctx context.Context, topic, id string, message interface{}) error
+	PublishRecord(ctx context.Context, record domain.OutboxRecord) error
 }
 
 type MessageSubscriber interface {
@@ -18,5 +28,26 @@ type MessageSubscriber interface {
 }
 
 type MessageRepository interface {
-	CreateNew(message *domain.ParsedMessage) error
+	CreateNew(ctx context.Context, message *domain.ParsedMessage) error
+	CreateWithOutbox(ctx context.Context, message *domain.ParsedMessage, schedule *domain.Schedule, outbox []domain.OutboxRecord) error
+}
+
+type OutboxRepository interface {
+	ClaimOutbox(owner string, limit int, lease time.Duration) ([]domain.OutboxRecord, error)
+	MarkSent(uuids []string) error
+	ReleaseOutbox(owner string, uuids []string) error
+	RecordFailure(uuid string, attempts int, reason string, failed bool) error
+}
+
+// SubscriberStatus reports the state of the subscription for health checks
+type SubscriberStatus interface {
+	Connected() bool
+	LastHandled() time.Time
+	BacklogSince() time.Time
+	Backlog() int
+}
+
+// RepositoryStatus reports whether the repository is reachable
+type RepositoryStatus interface {
+	Ping(ctx context.Context) error
 }
@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "caatsm"
+
+// InvalidLabel replaces a label value outside a bounded set, such as an
+// originator that is not an address
+const InvalidLabel = "invalid"
+
+var (
+	// MessagesReceived counts the telegrams handed to the handler
+	MessagesReceived = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "messages_received_total",
+		Help:      "Telegrams received from NATS.",
+	})
+	// MessagesParsed counts the telegrams by category, originator and whether they were parsed
+	MessagesParsed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "messages_parsed_total",
+		Help:      "Telegrams parsed, by category, originator and outcome.",
+	}, []string{"category", "originator", "parsed"})
+	// ParseDuration measures parsing a telegram
+	ParseDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "parse_duration_seconds",
+		Help:      "Time taken to parse a telegram.",
+		Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 14),
+	})
+	// PatternHits counts the body patterns that matched, by category and pattern position
+	PatternHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pattern_hits_total",
+		Help:      "Body patterns that matched, by category and position of the pattern.",
+	}, []string{"category", "pattern"})
+	// HasuraDuration measures the Hasura requests by operation
+	HasuraDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "hasura_request_duration_seconds",
+		Help:      "Latency of Hasura requests, by operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+	// HasuraErrors counts the failed Hasura requests by operation
+	HasuraErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "hasura_errors_total",
+		Help:      "Failed Hasura requests, by operation.",
+	}, []string{"operation"})
+	// Published counts the messages published by topic
+	Published = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "published_total",
+		Help:      "Messages published to NATS, by topic or subject template.",
+	}, []string{"topic"})
+	// PublishFailures counts the messages that could not be published by topic
+	PublishFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "publish_failures_total",
+		Help:      "Messages that could not be published to NATS, by topic or subject template.",
+	}, []string{"topic"})
+	// StreamSubscribers counts the clients of the live telegrams
+	StreamSubscribers = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "stream_subscribers",
+		Help:      "Clients subscribed to the live telegrams.",
+	})
+	// StreamDropped counts the live telegrams dropped for clients that could not keep up
+	StreamDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "stream_dropped_total",
+		Help:      "Live telegrams dropped for slow clients.",
+	})
+	// DeadLetters counts the telegrams sent to the dead-letter topic by stage
+	DeadLetters = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "dead_letters_total",
+		Help:      "Telegrams sent to the dead-letter topic, by stage.",
+	}, []string{"stage"})
+)
+
+// Handler serves the metrics in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveHasura records the latency and outcome of a Hasura request started at start
+func ObserveHasura(operation string, start time.Time, err error) {
+	HasuraDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		HasuraErrors.WithLabelValues(operation).Inc()
+	}
+}
+
+// ObservePublish records a message published, or not, on the topic. The topic
+// is a configured one, or the subject template, not a rendered subject.
+func ObservePublish(topic string, err error) {
+	if err != nil {
+		PublishFailures.WithLabelValues(topic).Inc()
+		return
+	}
+	Published.WithLabelValues(topic).Inc()
+}
+
+// ObserveParsed records the outcome of parsing a telegram. The originator is an
+// address or InvalidLabel.
+func ObserveParsed(category, originator string, parsed bool) {
+	MessagesParsed.WithLabelValues(category, originator, strconv.FormatBool(parsed)).Inc()
+}
@@ -0,0 +1,50 @@
+package nats
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"encoding/json"
+)
+
+// scheduleEventType follows the type prefix for the schedules published on
+// their own topic, apart from the schedule telegrams
+const scheduleEventType = "flight-schedule"
+
+// envelop wraps the payload of a record in a CloudEvent when a mode is
+// configured. In structured mode the payload becomes the event; in binary mode
+// it stays the message and the attributes are added as headers.
+func envelop(cfg config.CloudEventsConfig, record *domain.OutboxRecord, event *domain.CloudEvent) error {
+	switch cfg.Mode {
+	case domain.CloudEventsStructured:
+		event.Data = record.Payload
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		record.Payload = payload
+		record.Headers = map[string]string{"Content-Type": domain.CloudEventsContentType}
+	case domain.CloudEventsBinary:
+		record.Headers = event.Headers()
+	}
+	return nil
+}
+
+// cloudEvent returns the attributes of an event for a telegram, without the data
+func cloudEvent(cfg config.CloudEventsConfig, parsed *domain.ParsedMessage, id, kind, schema string) *domain.CloudEvent {
+	event := &domain.CloudEvent{
+		SpecVersion:     domain.CloudEventsSpecVersion,
+		ID:              id,
+		Source:          domain.EventSource(cfg.SourcePrefix, parsed.Originator),
+		Type:            domain.EventType(cfg.TypePrefix, kind),
+		DataContentType: domain.DataContentType,
+		Subject:         parsed.MessageID,
+	}
+	if cfg.DataSchema != "" {
+		event.DataSchema = domain.SchemaID(cfg.DataSchema, schema)
+	}
+	if !parsed.ReceivedAt.IsZero() {
+		received := parsed.ReceivedAt
+		event.Time = &received
+	}
+	return event
+}
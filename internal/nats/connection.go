@@ -0,0 +1,142 @@
+package nats
+
+import (
+	"caatsm/internal/config"
+	"caatsm/pkg/utils"
+	"errors"
+	"fmt"
+
+	nc "github.com/nats-io/nats.go"
+)
+
+// connect opens a NATS connection that keeps retrying while the server is down.
+// Extra options such as a closed handler are added after the configured ones.
+func connect(cfg *config.Config, extra ...nc.Option) (*nc.Conn, error) {
+	options := []nc.Option{
+		nc.Name(cfg.Nats.Client),
+		nc.RetryOnFailedConnect(true),
+		nc.Timeout(cfg.Timeouts.Server),
+		nc.ReconnectWait(cfg.Timeouts.ReconnectWait),
+	}
+	return nc.Connect(cfg.Nats.URL, append(options, extra...)...)
+}
+
+// jetStream returns the JetStream context of the connection with the configured
+// stream in place. It returns nil, to fall back to core NATS, when JetStream is
+// disabled in the configuration or not enabled on the server.
+func jetStream(conn *nc.Conn, cfg *config.Config) (nc.JetStreamContext, error) {
+	if !cfg.JetStream.Enabled {
+		return nil, nil
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureStream(js, cfg); err != nil {
+		if errors.Is(err, nc.ErrJetStreamNotEnabled) || errors.Is(err, nc.ErrJetStreamNotEnabledForAccount) {
+			utils.GetSugaredLogger().Warnf("JetStream is not available, falling back to core NATS: %v", err)
+			return nil, nil
+		}
+		return nil, err
+	}
+	return js, nil
+}
+
+// ensureStream creates the configured stream, or adds the subjects it is missing
+func ensureStream(js nc.JetStreamContext, cfg *config.Config) error {
+	subjects := streamSubjects(cfg)
+	info, err := js.StreamInfo(cfg.JetStream.Stream)
+	if errors.Is(err, nc.ErrStreamNotFound) {
+		_, err = js.AddStream(&nc.StreamConfig{
+			Name:     cfg.JetStream.Stream,
+			Subjects: subjects,
+		})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	missing := false
+	existing := make(map[string]bool)
+	for _, subject := range info.Config.Subjects {
+		existing[subject] = true
+	}
+	streamConfig := info.Config
+	for _, subject := range subjects {
+		if !existing[subject] {
+			streamConfig.Subjects = append(streamConfig.Subjects, subject)
+			missing = true
+		}
+	}
+	if missing {
+		_, err = js.UpdateStream(&streamConfig)
+	}
+	return err
+}
+
+// streamSubjects returns the topics the processor reads and writes. With a
+// subject template, the wildcard of the template is added, and the topics it
+// already matches are left out, as the subjects of a stream cannot overlap.
+func streamSubjects(cfg *config.Config) []string {
+	topics := []string{cfg.Subscription.Topic, cfg.Publisher.Topic, cfg.Publisher.ScheduleTopic, cfg.Publisher.DeadLetterTopic}
+	wildcard := ""
+	if template := subjectTemplate(cfg); template != nil {
+		wildcard = template.Wildcard()
+		topics = append([]string{wildcard}, topics...)
+	}
+	var subjects []string
+	seen := make(map[string]bool)
+	for _, subject := range topics {
+		if subject == "" || seen[subject] || (subject != wildcard && wildcard != "" && subjectMatches(wildcard, subject)) {
+			continue
+		}
+		seen[subject] = true
+		subjects = append(subjects, subject)
+	}
+	return subjects
+}
+
+// consumerConfig returns the durable push consumer of the subscription, which
+// delivers to the queue group when one is configured
+func consumerConfig(cfg *config.Config) (*nc.ConsumerConfig, error) {
+	consumer := &nc.ConsumerConfig{
+		Durable:        cfg.JetStream.Durable,
+		DeliverSubject: nc.NewInbox(),
+		DeliverGroup:   cfg.Subscription.QueueGroup,
+		FilterSubject:  cfg.Subscription.Topic,
+		AckWait:        cfg.Timeouts.AckWait,
+		MaxDeliver:     cfg.JetStream.MaxDeliver,
+	}
+	switch cfg.JetStream.AckPolicy {
+	case "", "explicit":
+		consumer.AckPolicy = nc.AckExplicitPolicy
+	case "all":
+		consumer.AckPolicy = nc.AckAllPolicy
+	case "none":
+		consumer.AckPolicy = nc.AckNonePolicy
+	default:
+		return nil, fmt.Errorf("invalid jetstream ack_policy: %s", cfg.JetStream.AckPolicy)
+	}
+	return consumer, nil
+}
+
+// ensureConsumer creates the durable consumer of the subscription unless it
+// exists. The subscriber binds to it, so unsubscribing leaves it in place.
+func ensureConsumer(js nc.JetStreamContext, cfg *config.Config) error {
+	_, err := js.ConsumerInfo(cfg.JetStream.Stream, cfg.JetStream.Durable)
+	if !errors.Is(err, nc.ErrConsumerNotFound) {
+		return err
+	}
+	consumer, err := consumerConfig(cfg)
+	if err != nil {
+		return err
+	}
+	if _, err = js.AddConsumer(cfg.JetStream.Stream, consumer); err != nil {
+		// another instance may have created it meanwhile
+		if _, infoErr := js.ConsumerInfo(cfg.JetStream.Stream, cfg.JetStream.Durable); infoErr == nil {
+			return nil
+		}
+	}
+	return err
+}
@@ -0,0 +1,155 @@
+package nats
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"caatsm/internal/metrics"
+	"encoding/json"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	nc "github.com/nats-io/nats.go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Dead letters", func() {
+	var (
+		cfg     *config.Config
+		handler *channelHandler
+		js      nc.JetStreamContext
+		letters *nc.Subscription
+	)
+
+	// nextLetter waits for the next message on the dead-letter topic
+	nextLetter := func() domain.DeadLetter {
+		msg, err := letters.NextMsg(5 * time.Second)
+		Expect(err).NotTo(HaveOccurred())
+		var letter domain.DeadLetter
+		Expect(json.Unmarshal(msg.Data, &letter)).To(Succeed())
+		return letter
+	}
+
+	BeforeEach(func() {
+		handler = newChannelHandler()
+	})
+
+	Context("with JetStream", func() {
+		var srv *server.Server
+
+		BeforeEach(func() {
+			srv = runServer(true)
+			cfg = testConfig(srv.ClientURL(), true)
+			conn, err := nc.Connect(srv.ClientURL())
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(conn.Close)
+			js, err = conn.JetStream()
+			Expect(err).NotTo(HaveOccurred())
+			startSubscriber(cfg, handler)
+			Eventually(func() error {
+				_, err := js.ConsumerInfo("TELEGRAMS", "caatsm")
+				return err
+			}).Should(Succeed())
+			letters, err = conn.SubscribeSync("Telegram.DeadLetter")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should dead-letter a rejected message without retrying it", func() {
+			count := testutil.ToFloat64(metrics.DeadLetters.WithLabelValues(domain.StageParse))
+			_, err := js.Publish("Telegram.Serial", []byte("reject"))
+			Expect(err).NotTo(HaveOccurred())
+
+			letter := nextLetter()
+			Expect(letter.Stage).To(Equal(domain.StageParse))
+			Expect(letter.Reason).To(Equal("no category found in body text"))
+			Expect(letter.Attempts).To(Equal(1))
+			Expect(letter.Payload).To(Equal("reject"))
+			Consistently(handler.handled, 300*time.Millisecond).Should(HaveLen(1))
+			Eventually(func() float64 {
+				return testutil.ToFloat64(metrics.DeadLetters.WithLabelValues(domain.StageParse))
+			}).Should(Equal(count + 1))
+		})
+
+		It("should dead-letter a message that still fails on its last delivery", func() {
+			handler.failures["telegram"] = 10
+			_, err := js.Publish("Telegram.Serial", []byte("telegram"))
+			Expect(err).NotTo(HaveOccurred())
+
+			letter := nextLetter()
+			Expect(letter.Stage).To(Equal(domain.StagePersistence))
+			Expect(letter.Reason).To(ContainSubstring("failing telegram"))
+			Expect(letter.Attempts).To(Equal(3))
+			Expect(receive(handler, 3)).To(HaveLen(3))
+		})
+
+		It("should re-drive the dead letters to the subscription topic", func() {
+			for _, payload := range []string{"reject", "reject"} {
+				_, err := js.Publish("Telegram.Serial", []byte(payload))
+				Expect(err).NotTo(HaveOccurred())
+				nextLetter()
+			}
+			Expect(receive(handler, 2)).To(HaveLen(2))
+
+			count, err := Redrive(cfg, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+			Expect(receive(handler, 1)).To(Equal([]string{"reject"}))
+			nextLetter()
+
+			count, err = Redrive(cfg, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(2))
+		})
+
+		It("should re-drive a publishing-stage dead letter to its own topic", func() {
+			conn, err := nc.Connect(srv.ClientURL())
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(conn.Close)
+			published, err := conn.SubscribeSync("Telegram.Json")
+			Expect(err).NotTo(HaveOccurred())
+
+			letter := domain.NewDeadLetter("record-1", []byte(`{"uuid":"telegram-1"}`), domain.StagePublishing, "publish failed", 5)
+			letter.Topic = "Telegram.Json"
+			letter.Headers = map[string]string{"ce-type": "caatsm.telegram.parsed"}
+			data, err := json.Marshal(letter)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = js.Publish("Telegram.DeadLetter", data)
+			Expect(err).NotTo(HaveOccurred())
+			nextLetter()
+
+			count, err := Redrive(cfg, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+			msg, err := published.NextMsg(5 * time.Second)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(msg.Data)).To(Equal(`{"uuid":"telegram-1"}`))
+			Expect(msg.Header.Get("ce-type")).To(Equal("caatsm.telegram.parsed"))
+			Consistently(handler.handled, 300*time.Millisecond).Should(BeEmpty())
+		})
+	})
+
+	It("should dead-letter with core NATS and refuse to re-drive", func() {
+		srv := runServer(false)
+		cfg = testConfig(srv.ClientURL(), false)
+		startSubscriber(cfg, handler)
+		conn, err := nc.Connect(srv.ClientURL())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+		letters, err = conn.SubscribeSync("Telegram.DeadLetter")
+		Expect(err).NotTo(HaveOccurred())
+
+		handler.failures["telegram"] = 1
+		Eventually(func() int {
+			Expect(conn.Publish("Telegram.Serial", []byte("telegram"))).To(Succeed())
+			time.Sleep(20 * time.Millisecond)
+			return len(handler.handled)
+		}).Should(BeNumerically(">", 0))
+		letter := nextLetter()
+		Expect(letter.Stage).To(Equal(domain.StagePersistence))
+		Expect(letter.Attempts).To(Equal(1))
+
+		_, err = Redrive(cfg, 0)
+		Expect(err).To(MatchError(ContainSubstring("needs JetStream")))
+	})
+})
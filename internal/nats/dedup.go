@@ -0,0 +1,76 @@
+package nats
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// DuplicateWindow remembers the telegrams saved recently, so a retransmission
+// or redelivery within the window is skipped before reaching the repository.
+// It holds at most size telegrams, forgetting the oldest first.
+type DuplicateWindow struct {
+	window time.Duration
+	size   int
+	now    func() time.Time
+	mu     sync.Mutex
+	seen   map[string]*list.Element
+	order  *list.List
+}
+
+type seenTelegram struct {
+	key  string
+	time time.Time
+}
+
+func NewDuplicateWindow(window time.Duration, size int) *DuplicateWindow {
+	return &DuplicateWindow{
+		window: window,
+		size:   size,
+		now:    time.Now,
+		seen:   make(map[string]*list.Element),
+		order:  list.New(),
+	}
+}
+
+// Seen reports whether the key was added within the window
+func (w *DuplicateWindow) Seen(key string) bool {
+	if w.window <= 0 {
+		return false
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.expire()
+	_, ok := w.seen[key]
+	return ok
+}
+
+// Add records the key, restarting its window when it was already there
+func (w *DuplicateWindow) Add(key string) {
+	if w.window <= 0 {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if element, ok := w.seen[key]; ok {
+		w.order.Remove(element)
+	}
+	w.seen[key] = w.order.PushBack(seenTelegram{key: key, time: w.now()})
+	for w.size > 0 && w.order.Len() > w.size {
+		w.remove(w.order.Front())
+	}
+	w.expire()
+}
+
+// expire forgets the keys older than the window, which are at the front
+func (w *DuplicateWindow) expire() {
+	limit := w.now().Add(-w.window)
+	for element := w.order.Front(); element != nil && !element.Value.(seenTelegram).time.After(limit); element = w.order.Front() {
+		w.remove(element)
+	}
+}
+
+func (w *DuplicateWindow) remove(element *list.Element) {
+	delete(w.seen, element.Value.(seenTelegram).key)
+	w.order.Remove(element)
+}
@@ -0,0 +1,49 @@
+package nats
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DuplicateWindow", func() {
+	var (
+		now    time.Time
+		window *DuplicateWindow
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+		window = NewDuplicateWindow(time.Minute, 2)
+		window.now = func() time.Time { return now }
+	})
+
+	It("should report a key added within the window", func() {
+		Expect(window.Seen("a")).To(BeFalse())
+		window.Add("a")
+		now = now.Add(30 * time.Second)
+		Expect(window.Seen("a")).To(BeTrue())
+	})
+
+	It("should forget a key once the window has passed", func() {
+		window.Add("a")
+		now = now.Add(time.Minute)
+		Expect(window.Seen("a")).To(BeFalse())
+	})
+
+	It("should forget the oldest key when full", func() {
+		window.Add("a")
+		window.Add("b")
+		window.Add("c")
+		Expect(window.Seen("a")).To(BeFalse())
+		Expect(window.Seen("b")).To(BeTrue())
+		Expect(window.Seen("c")).To(BeTrue())
+	})
+
+	It("should remember nothing without a window", func() {
+		window = NewDuplicateWindow(0, 2)
+		window.Add("a")
+		Expect(window.Seen("a")).To(BeFalse())
+	})
+})
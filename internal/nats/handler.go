@@ -4,46 +4,140 @@ import (
 	"caatsm/internal/config"
 	"caatsm/internal/domain"
 	"caatsm/internal/iface"
+	"caatsm/internal/metrics"
 	"caatsm/internal/parsers"
+	"caatsm/internal/tracing"
 	"caatsm/pkg/utils"
+	"context"
 	"fmt"
-	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
+// MessageHandler parses and saves telegrams, with the messages to publish in
+// the outbox. It holds no state of its own, so it can be called from several
+// workers at once.
 type MessageHandler struct {
-	mu         sync.Mutex
 	config     *config.Config
 	repository iface.MessageRepository
-	publisher  iface.MessagePublisher
+	recent     *DuplicateWindow
+	subject    *domain.SubjectTemplate
+	listeners  []iface.MessageListener
 }
 
-func NewHandler(config *config.Config, publisher iface.MessagePublisher, repository iface.MessageRepository) *MessageHandler {
+func NewHandler(config *config.Config, repository iface.MessageRepository) *MessageHandler {
 	return &MessageHandler{
 		config:     config,
 		repository: repository,
-		publisher:  publisher,
+		recent:     NewDuplicateWindow(config.Dedup.Window, config.Dedup.Size),
+		subject:    subjectTemplate(config),
 	}
 }
 
-func (handler *MessageHandler) HandleMessage(msg []byte, id string) error {
-	handler.mu.Lock()
-	defer handler.mu.Unlock()
+// AddListener tells a listener of each telegram parsed and saved from now on.
+// Listeners are added before the handler is subscribed.
+func (handler *MessageHandler) AddListener(listener iface.MessageListener) {
+	handler.listeners = append(handler.listeners, listener)
+}
+
+// HandleMessage parses and saves a telegram. A telegram that cannot be parsed
+// or validated is saved alone, and a parsers.RejectedError is returned so it is
+// dead-lettered. Otherwise the telegram, its schedule lines and the messages to
+// publish are saved together in the outbox, which the OutboxRelay publishes.
+// Saving is retried with the configured backoff, and a SinkError is returned
+// when it still fails, so the message is redelivered. The telegram is saved
+// under its Identity, and one already saved within the dedup window is skipped.
+// The trace context of ctx is kept in the message and its outbox records.
+// The listeners are told of a telegram once it is saved with its outbox.
+func (handler *MessageHandler) HandleMessage(ctx context.Context, msg []byte, id string) error {
 	log := utils.GetSugaredLogger()
+	metrics.MessagesReceived.Inc()
 	if msg == nil {
 		log.Error("empty message")
-		return fmt.Errorf("empty message")
+		return &parsers.RejectedError{Stage: domain.StageParse, Reason: "empty message"}
 	}
 	payload := string(msg)
-	var parsed *domain.ParsedMessage
-	if parsed = parsers.Parse(payload); !parsed.Parsed {
-		log.Infof("not parsed: [%s] : {%s} \n", id, payload)
-	} else {
-		parsed.Uuid = id
-		log.Infof("parsed [%s]: %v\n", id, parsed.ToString())
+	start := time.Now()
+	_, span := tracing.Start(ctx, "parsers.Parse")
+	parsed, schedule := parsers.ParseTelegram(payload)
+	span.SetAttributes(attribute.String("telegram.uuid", parsed.Uuid), attribute.String("telegram.category", parsed.Category))
+	tracing.End(span, nil)
+	metrics.ParseDuration.Observe(time.Since(start).Seconds())
+	if handler.recent.Seen(parsed.Uuid) {
+		log.Infof("duplicate [%s] of telegram %s skipped\n", id, parsed.Uuid)
+		return nil
+	}
+	metrics.ObserveParsed(parsed.Category, originatorLabel(parsed.Originator), parsed.Parsed)
+	parsed.TraceContext = tracing.Inject(ctx)
+	rejected := parsers.Validate(parsed, schedule)
+	if rejected != nil {
+		log.Infof("not parsed: [%s] : {%s} %v\n", id, payload, rejected)
+		if err := handler.save(id, func() error { return handler.repository.CreateNew(ctx, parsed) }); err != nil {
+			return err
+		}
+		return rejected
 	}
-	handler.repository.CreateNew(parsed)
 
-	handler.publisher.Publish(parsed)
+	log.Infof("parsed [%s]: %v\n", id, parsed.ToString())
+	outbox, err := handler.outbox(parsed, schedule)
+	if err != nil {
+		return &parsers.RejectedError{Stage: domain.StageParse, Reason: err.Error()}
+	}
+	if err := handler.save(id, func() error { return handler.repository.CreateWithOutbox(ctx, parsed, schedule, outbox) }); err != nil {
+		return err
+	}
+	handler.recent.Add(parsed.Uuid)
+	for _, listener := range handler.listeners {
+		listener.OnMessage(parsed)
+	}
+	return nil
+}
 
+// save runs a repository call with the configured retries
+func (handler *MessageHandler) save(id string, create func() error) error {
+	if err := retry(handler.config.Retry.Repository, "save message "+id, create); err != nil {
+		return &SinkError{Stage: domain.StagePersistence, Err: fmt.Errorf("failed to save message [%s]: %v", id, err)}
+	}
 	return nil
 }
+
+// outbox returns the messages to publish for a telegram: the telegram itself,
+// on the subject rendered from the template or on the publisher topic, and the
+// schedule on its own topic for a schedule telegram. Each one is wrapped in a
+// CloudEvent when configured.
+func (handler *MessageHandler) outbox(parsed *domain.ParsedMessage, schedule *domain.Schedule) ([]domain.OutboxRecord, error) {
+	events := handler.config.Publisher.CloudEvents
+	record, err := domain.NewOutboxRecord(parsed.Uuid, subject(handler.subject, handler.config.Publisher.Topic, parsed), parsed)
+	if err != nil {
+		return nil, err
+	}
+	record.TraceContext = parsed.TraceContext
+	if err = envelop(events, record, cloudEvent(events, parsed, parsed.Uuid, parsed.Category, domain.SchemaParsedMessage)); err != nil {
+		return nil, err
+	}
+	records := []domain.OutboxRecord{*record}
+	if schedule == nil {
+		return records, nil
+	}
+	utils.GetSugaredLogger().Infof("schedule [%s]: %s %s, %d lines parsed, %d cancelled, %d failed, %d skipped\n", parsed.Uuid,
+		schedule.Airline, schedule.Date, schedule.Summary.Parsed, schedule.Summary.Cancelled,
+		schedule.Summary.Failed, schedule.Summary.Skipped)
+	if record, err = domain.NewOutboxRecord(parsed.Uuid, handler.config.Publisher.ScheduleTopic, schedule); err != nil {
+		return nil, err
+	}
+	record.TraceContext = parsed.TraceContext
+	if err = envelop(events, record, cloudEvent(events, parsed, record.Uuid, scheduleEventType, domain.SchemaSchedule)); err != nil {
+		return nil, err
+	}
+	return append(records, *record), nil
+}
+
+// originatorLabel returns the originator to count the telegram under, InvalidLabel
+// when it is not an AFTN or SITA address
+func originatorLabel(originator string) string {
+	if parsers.IsValidAddress(originator) || parsers.IsSITAAddress(originator) {
+		return originator
+	}
+	return metrics.InvalidLabel
+}
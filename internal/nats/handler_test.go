@@ -0,0 +1,442 @@
+package nats
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"caatsm/internal/metrics"
+	"caatsm/internal/parsers"
+	"caatsm/internal/tracing"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type published struct {
+	topic   string
+	id      string
+	message interface{}
+	headers map[string]string
+}
+
+type fakePublisher struct {
+	mu       sync.Mutex
+	topic    string
+	messages []published
+	failures int
+}
+
+func (p *fakePublisher) Publish(message interface{}) error {
+	return p.PublishTo(p.topic, message)
+}
+
+func (p *fakePublisher) PublishTo(topic string, message interface{}) error {
+	return p.PublishWithID(context.Background(), topic, "", message)
+}
+
+func (p *fakePublisher) PublishWithID(ctx context.Context, topic, id string, message interface{}) error {
+	return p.add(published{topic: topic, id: id, message: message})
+}
+
+func (p *fakePublisher) PublishRecord(ctx context.Context, record domain.OutboxRecord) error {
+	return p.add(published{topic: record.Topic, id: record.Uuid, message: record.Payload, headers: record.Headers})
+}
+
+func (p *fakePublisher) add(message published) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("nats is down")
+	}
+	p.messages = append(p.messages, message)
+	return nil
+}
+
+func (p *fakePublisher) count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.messages)
+}
+
+type fakeRepository struct {
+	messages  []*domain.ParsedMessage
+	schedules map[string]*domain.Schedule
+	outbox    []domain.OutboxRecord
+	sent      map[string]bool
+	claims    map[string]string
+	attempts  map[string]int
+	failed    map[string]bool
+	err       error
+	failures  int
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{
+		schedules: make(map[string]*domain.Schedule),
+		sent:      make(map[string]bool),
+		claims:    make(map[string]string),
+		attempts:  make(map[string]int),
+		failed:    make(map[string]bool),
+	}
+}
+
+func (r *fakeRepository) fail() error {
+	if r.err != nil {
+		return r.err
+	}
+	if r.failures > 0 {
+		r.failures--
+		return errors.New("hasura is unavailable")
+	}
+	return nil
+}
+
+func (r *fakeRepository) CreateNew(ctx context.Context, message *domain.ParsedMessage) error {
+	if err := r.fail(); err != nil {
+		return err
+	}
+	r.messages = append(r.messages, message)
+	return nil
+}
+
+func (r *fakeRepository) CreateWithOutbox(ctx context.Context, message *domain.ParsedMessage, schedule *domain.Schedule, outbox []domain.OutboxRecord) error {
+	if err := r.fail(); err != nil {
+		return err
+	}
+	r.messages = append(r.messages, message)
+	if schedule != nil {
+		r.schedules[message.Uuid] = schedule
+	}
+	r.outbox = append(r.outbox, outbox...)
+	return nil
+}
+
+func (r *fakeRepository) ClaimOutbox(owner string, limit int, lease time.Duration) ([]domain.OutboxRecord, error) {
+	if err := r.fail(); err != nil {
+		return nil, err
+	}
+	var claimed []domain.OutboxRecord
+	for _, record := range r.outbox {
+		claimedBy, ok := r.claims[record.Uuid]
+		if r.sent[record.Uuid] || r.failed[record.Uuid] || ok && claimedBy != owner || len(claimed) == limit {
+			continue
+		}
+		r.claims[record.Uuid] = owner
+		record.Attempts = r.attempts[record.Uuid]
+		claimed = append(claimed, record)
+	}
+	return claimed, nil
+}
+
+func (r *fakeRepository) ReleaseOutbox(owner string, uuids []string) error {
+	for _, id := range uuids {
+		if r.claims[id] == owner {
+			delete(r.claims, id)
+		}
+	}
+	return nil
+}
+
+func (r *fakeRepository) RecordFailure(uuid string, attempts int, reason string, failed bool) error {
+	r.attempts[uuid] = attempts
+	r.failed[uuid] = failed
+	if !failed {
+		delete(r.claims, uuid)
+	}
+	return nil
+}
+
+func (r *fakeRepository) MarkSent(uuids []string) error {
+	if err := r.fail(); err != nil {
+		return err
+	}
+	for _, id := range uuids {
+		r.sent[id] = true
+	}
+	return nil
+}
+
+type fakeListener struct {
+	messages []*domain.ParsedMessage
+}
+
+func (l *fakeListener) OnMessage(message *domain.ParsedMessage) {
+	l.messages = append(l.messages, message)
+}
+
+var _ = Describe("MessageHandler", func() {
+	var (
+		cfg        *config.Config
+		repository *fakeRepository
+		handler    *MessageHandler
+	)
+
+	BeforeEach(func() {
+		sleep = func(time.Duration) {}
+		DeferCleanup(func() { sleep = time.Sleep })
+		cfg = &config.Config{Publisher: config.PublisherConfig{Topic: "Telegram.Json", ScheduleTopic: "Telegram.Schedule"}}
+		repository = newFakeRepository()
+		handler = NewHandler(cfg, repository)
+	})
+
+	It("should save a schedule telegram with its schedule in the outbox", func() {
+		text := `ZCZC TAD123 301200
+GG ZBTJZPZX ZBTJKCHU
+301158 ZBTJHUXX
+HU TSN SCHEDULE FOR 31OCT
+L05 W/Z HU7205 B5406 (9) TSN/2355(30OCT) PVG
+L06 W/Z HU7206 B5406 (9) PVG/0300 TSN
+NNNN`
+		Expect(handler.HandleMessage(context.Background(), []byte(text), "id-1")).To(Succeed())
+
+		Expect(repository.messages).To(HaveLen(1))
+		Expect(repository.messages[0].Category).To(Equal(parsers.CategorySchedule))
+		telegram := repository.messages[0].Uuid
+		Expect(repository.schedules).To(HaveKey(telegram))
+		Expect(repository.schedules[telegram].Lines).To(HaveLen(2))
+
+		Expect(repository.outbox).To(HaveLen(2))
+		Expect(repository.outbox[0].Topic).To(Equal("Telegram.Json"))
+		Expect(repository.outbox[0].TelegramUuid).To(Equal(telegram))
+		Expect(repository.outbox[1].Topic).To(Equal("Telegram.Schedule"))
+		Expect(string(repository.outbox[1].Payload)).To(ContainSubstring("HU7205"))
+	})
+
+	It("should count a telegram without an originator address as invalid", func() {
+		text := `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ANY TEXT
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+		invalid := testutil.ToFloat64(metrics.MessagesParsed.WithLabelValues("ARR", metrics.InvalidLabel, "true"))
+		handler.HandleMessage(context.Background(), []byte(text), "id-invalid")
+		Expect(testutil.ToFloat64(metrics.MessagesParsed.WithLabelValues("ARR", metrics.InvalidLabel, "true"))).To(Equal(invalid + 1))
+	})
+
+	It("should save a parsed ATS message in the outbox", func() {
+		text := `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+		received := testutil.ToFloat64(metrics.MessagesReceived)
+		parsedCount := testutil.ToFloat64(metrics.MessagesParsed.WithLabelValues("ARR", "ZSHCZTZX", "true"))
+		Expect(handler.HandleMessage(context.Background(), []byte(text), "id-2")).To(Succeed())
+		Expect(testutil.ToFloat64(metrics.MessagesReceived)).To(Equal(received + 1))
+		Expect(testutil.ToFloat64(metrics.MessagesParsed.WithLabelValues("ARR", "ZSHCZTZX", "true"))).To(Equal(parsedCount + 1))
+		Expect(repository.schedules).To(BeEmpty())
+		Expect(repository.outbox).To(HaveLen(1))
+		Expect(repository.outbox[0].Topic).To(Equal("Telegram.Json"))
+	})
+
+	It("should tell the listeners of the saved telegrams only", func() {
+		listener := &fakeListener{}
+		handler.AddListener(listener)
+		text := `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+		Expect(handler.HandleMessage(context.Background(), []byte(text), "id-2")).To(Succeed())
+		Expect(handler.HandleMessage(context.Background(), []byte("NOT A TELEGRAM"), "id-3")).NotTo(Succeed())
+		repository.err = errors.New("hasura is down")
+		Expect(handler.HandleMessage(context.Background(), []byte(strings.Replace(text, "TMQ2530", "TMQ2531", 1)), "id-4")).NotTo(Succeed())
+
+		Expect(listener.messages).To(HaveLen(1))
+		Expect(listener.messages[0].Category).To(Equal("ARR"))
+	})
+
+	It("should save without an outbox record a message that cannot be parsed", func() {
+		err := handler.HandleMessage(context.Background(), []byte("NOT A TELEGRAM"), "id-3")
+		var rejected *parsers.RejectedError
+		Expect(errors.As(err, &rejected)).To(BeTrue())
+		Expect(rejected.Stage).To(Equal(domain.StageParse))
+		Expect(repository.messages).To(HaveLen(1))
+		Expect(repository.outbox).To(BeEmpty())
+	})
+
+	It("should reject an ATS message with an invalid header", func() {
+		text := `ZCZC TMQ2530 141614
+GG ZBTJ
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+		err := handler.HandleMessage(context.Background(), []byte(text), "id-4")
+		var rejected *parsers.RejectedError
+		Expect(errors.As(err, &rejected)).To(BeTrue())
+		Expect(rejected.Stage).To(Equal(domain.StageValidation))
+		Expect(rejected.Reason).To(ContainSubstring("primaryAddress"))
+		Expect(repository.outbox).To(BeEmpty())
+	})
+
+	It("should publish an ATS message with an invalid secondary address, with a warning", func() {
+		text := `ZCZC TMQ2530 141614
+GG ZBTJZXZX PEKUDCA
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+		Expect(handler.HandleMessage(context.Background(), []byte(text), "id-4")).To(Succeed())
+		Expect(repository.outbox).NotTo(BeEmpty())
+		Expect(repository.messages[0].HeaderErrors).To(BeEmpty())
+		Expect(repository.messages[0].HeaderWarnings).To(HaveLen(1))
+		Expect(repository.messages[0].HeaderWarnings[0].Value).To(Equal("PEKUDCA"))
+		Expect(string(repository.outbox[0].Payload)).To(ContainSubstring("headerWarnings"))
+	})
+
+	It("should return the repository error", func() {
+		repository.err = errors.New("hasura is down")
+		err := handler.HandleMessage(context.Background(), []byte("NOT A TELEGRAM"), "id-5")
+		Expect(err).To(MatchError(ContainSubstring("hasura is down")))
+		var rejected *parsers.RejectedError
+		Expect(errors.As(err, &rejected)).To(BeFalse())
+		var failed *SinkError
+		Expect(errors.As(err, &failed)).To(BeTrue())
+		Expect(failed.Stage).To(Equal(domain.StagePersistence))
+	})
+
+	It("should retry saving with backoff until it succeeds", func() {
+		var waits []time.Duration
+		sleep = func(d time.Duration) { waits = append(waits, d) }
+		cfg.Retry.Repository = config.RetryConfig{Attempts: 3, InitialInterval: 100 * time.Millisecond, Multiplier: 2}
+		repository.failures = 2
+		Expect(handler.HandleMessage(context.Background(), []byte("NOT A TELEGRAM"), "id-7")).To(MatchError(ContainSubstring("rejected")))
+		Expect(repository.messages).To(HaveLen(1))
+		Expect(waits).To(Equal([]time.Duration{100 * time.Millisecond, 200 * time.Millisecond}))
+	})
+
+	It("should give up saving once the attempts are used up", func() {
+		cfg.Retry.Repository = config.RetryConfig{Attempts: 2}
+		repository.failures = 2
+		err := handler.HandleMessage(context.Background(), []byte("NOT A TELEGRAM"), "id-8")
+		Expect(err).To(MatchError(ContainSubstring("hasura is unavailable")))
+		Expect(repository.messages).To(BeEmpty())
+	})
+
+	It("should skip a telegram saved within the dedup window", func() {
+		cfg.Dedup = config.DedupConfig{Window: time.Minute}
+		handler = NewHandler(cfg, repository)
+		text := `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+		Expect(handler.HandleMessage(context.Background(), []byte(text), "id-10")).To(Succeed())
+		Expect(handler.HandleMessage(context.Background(), []byte(text), "id-11")).To(Succeed())
+		Expect(repository.messages).To(HaveLen(1))
+		Expect(repository.outbox).To(HaveLen(1))
+	})
+
+	It("should save a redelivered telegram again under the same uuid without the window", func() {
+		text := `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+		Expect(handler.HandleMessage(context.Background(), []byte(text), "id-12")).To(Succeed())
+		Expect(handler.HandleMessage(context.Background(), []byte(text), "id-13")).To(Succeed())
+		Expect(repository.messages).To(HaveLen(2))
+		Expect(repository.messages[1].Uuid).To(Equal(repository.messages[0].Uuid))
+		Expect(repository.outbox[1].Uuid).To(Equal(repository.outbox[0].Uuid))
+	})
+
+	It("should not remember a telegram that was rejected", func() {
+		cfg.Dedup = config.DedupConfig{Window: time.Minute}
+		handler = NewHandler(cfg, repository)
+		Expect(handler.HandleMessage(context.Background(), []byte("NOT A TELEGRAM"), "id-14")).NotTo(Succeed())
+		Expect(handler.HandleMessage(context.Background(), []byte("NOT A TELEGRAM"), "id-15")).NotTo(Succeed())
+		Expect(repository.messages).To(HaveLen(2))
+	})
+
+	It("should keep the trace context in the message and its outbox", func() {
+		text := `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+		traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+		ctx := tracing.Extract(context.Background(), map[string]string{"traceparent": traceparent})
+		Expect(handler.HandleMessage(ctx, []byte(text), "id-16")).To(Succeed())
+		Expect(repository.messages[0].TraceContext).To(HaveKeyWithValue("traceparent", traceparent))
+		Expect(repository.outbox[0].TraceContext).To(HaveKeyWithValue("traceparent", traceparent))
+		Expect(string(repository.outbox[0].Payload)).To(ContainSubstring(traceparent))
+	})
+
+	It("should save the message on the subject rendered from the template", func() {
+		cfg.Publisher.SubjectTemplate = "Telegram.Json.{category}.{originator}.{dep}"
+		handler = NewHandler(cfg, repository)
+		text := `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+		Expect(handler.HandleMessage(context.Background(), []byte(text), "id-17")).To(Succeed())
+		Expect(repository.outbox).To(HaveLen(1))
+		Expect(repository.outbox[0].Topic).To(Equal("Telegram.Json.ARR.ZSHCZTZX.ZBTJ"))
+	})
+
+	Context("with CloudEvents", func() {
+		text := `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+
+		BeforeEach(func() {
+			cfg.Publisher.CloudEvents = config.CloudEventsConfig{
+				TypePrefix:   "caatsm.telegram",
+				SourcePrefix: "/aftn",
+				DataSchema:   "urn:caatsm:schema:",
+			}
+		})
+
+		It("should wrap the message in a structured event", func() {
+			cfg.Publisher.CloudEvents.Mode = domain.CloudEventsStructured
+			Expect(handler.HandleMessage(context.Background(), []byte(text), "id-18")).To(Succeed())
+			record := repository.outbox[0]
+			Expect(record.Headers).To(HaveKeyWithValue("Content-Type", "application/cloudevents+json"))
+
+			var event domain.CloudEvent
+			Expect(json.Unmarshal(record.Payload, &event)).To(Succeed())
+			Expect(event.SpecVersion).To(Equal("1.0"))
+			Expect(event.ID).To(Equal(repository.messages[0].Uuid))
+			Expect(event.Type).To(Equal("caatsm.telegram.arr"))
+			Expect(event.Source).To(Equal("/aftn/ZSHCZTZX"))
+			Expect(event.DataSchema).To(Equal("urn:caatsm:schema:parsed-message/" + domain.SchemaVersion))
+			Expect(event.Subject).To(Equal("TMQ2530"))
+			Expect(event.Time).NotTo(BeNil())
+
+			var message domain.ParsedMessage
+			Expect(json.Unmarshal(event.Data, &message)).To(Succeed())
+			Expect(message.Category).To(Equal("ARR"))
+		})
+
+		It("should put the attributes in headers in binary mode", func() {
+			cfg.Publisher.CloudEvents.Mode = domain.CloudEventsBinary
+			Expect(handler.HandleMessage(context.Background(), []byte(text), "id-19")).To(Succeed())
+			record := repository.outbox[0]
+			Expect(record.Headers).To(HaveKeyWithValue("ce-type", "caatsm.telegram.arr"))
+			Expect(record.Headers).To(HaveKeyWithValue("ce-id", repository.messages[0].Uuid))
+			Expect(record.Headers).To(HaveKeyWithValue("Content-Type", "application/json"))
+
+			var message domain.ParsedMessage
+			Expect(json.Unmarshal(record.Payload, &message)).To(Succeed())
+			Expect(message.Category).To(Equal("ARR"))
+		})
+
+		It("should leave the message bare without a mode", func() {
+			Expect(handler.HandleMessage(context.Background(), []byte(text), "id-20")).To(Succeed())
+			Expect(repository.outbox[0].Headers).To(BeNil())
+		})
+	})
+
+	It("should reject an empty message", func() {
+		Expect(handler.HandleMessage(context.Background(), nil, "id-6")).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,306 @@
+package nats
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"caatsm/internal/metrics"
+	"caatsm/internal/parsers"
+	"caatsm/internal/pb"
+	"caatsm/internal/tracing"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	nc "github.com/nats-io/nats.go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/protobuf/proto"
+)
+
+// runServer starts an embedded nats-server on a random port
+func runServer(jetStream bool) *server.Server {
+	opts := &server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: jetStream,
+		StoreDir:  GinkgoT().TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	}
+	srv, err := server.NewServer(opts)
+	Expect(err).NotTo(HaveOccurred())
+	go srv.Start()
+	Expect(srv.ReadyForConnections(5 * time.Second)).To(BeTrue())
+	DeferCleanup(srv.Shutdown)
+	return srv
+}
+
+func testConfig(url string, jetStream bool) *config.Config {
+	return &config.Config{
+		Nats:         config.NatsConfig{Client: "test", URL: url},
+		Subscription: config.SubscriptionConfig{Topic: "Telegram.Serial", Workers: 2},
+		Publisher:    config.PublisherConfig{Topic: "Telegram.Json", ScheduleTopic: "Telegram.Schedule", DeadLetterTopic: "Telegram.DeadLetter"},
+		Timeouts:     config.TimeoutsConfig{Server: time.Second, ReconnectWait: 100 * time.Millisecond, AckWait: time.Second},
+		JetStream: config.JetStreamConfig{
+			Enabled:    jetStream,
+			Stream:     "TELEGRAMS",
+			Durable:    "caatsm",
+			AckPolicy:  "explicit",
+			MaxDeliver: 3,
+		},
+	}
+}
+
+// channelHandler sends the handled payloads on a channel, failing the first
+// attempts of the payloads listed in failures
+type channelHandler struct {
+	mu       sync.Mutex
+	failures map[string]int
+	handled  chan string
+}
+
+func newChannelHandler() *channelHandler {
+	return &channelHandler{failures: make(map[string]int), handled: make(chan string, 100)}
+}
+
+func (h *channelHandler) HandleMessage(ctx context.Context, msg []byte, id string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handled <- string(msg)
+	if string(msg) == "reject" {
+		return &parsers.RejectedError{Stage: domain.StageParse, Reason: "no category found in body text"}
+	}
+	if h.failures[string(msg)] > 0 {
+		h.failures[string(msg)]--
+		return fmt.Errorf("failing %s", msg)
+	}
+	return nil
+}
+
+// startSubscriber runs Subscribe in the background and returns the subscriber
+func startSubscriber(cfg *config.Config, handler *channelHandler) *NatsSubscriber {
+	subscriber := NewSub(cfg)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		subscriber.Subscribe(cfg, handler)
+	}()
+	DeferCleanup(func() {
+		subscriber.Close()
+		Eventually(done).Should(BeClosed())
+	})
+	return subscriber
+}
+
+func receive(handler *channelHandler, count int) []string {
+	var received []string
+	for i := 0; i < count; i++ {
+		var payload string
+		Eventually(handler.handled, 5*time.Second).Should(Receive(&payload))
+		received = append(received, payload)
+	}
+	return received
+}
+
+var _ = Describe("JetStream", func() {
+	var (
+		srv     *server.Server
+		cfg     *config.Config
+		handler *channelHandler
+		conn    *nc.Conn
+		js      nc.JetStreamContext
+	)
+
+	BeforeEach(func() {
+		srv = runServer(true)
+		cfg = testConfig(srv.ClientURL(), true)
+		handler = newChannelHandler()
+		var err error
+		conn, err = nc.Connect(srv.ClientURL())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+		js, err = conn.JetStream()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should create the stream and a durable consumer", func() {
+		subscriber := startSubscriber(cfg, handler)
+		Expect(subscriber.js).NotTo(BeNil())
+
+		stream, err := js.StreamInfo("TELEGRAMS")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stream.Config.Subjects).To(ConsistOf("Telegram.Serial", "Telegram.Json", "Telegram.Schedule", "Telegram.DeadLetter"))
+
+		var consumer *nc.ConsumerInfo
+		Eventually(func() (err error) {
+			consumer, err = js.ConsumerInfo("TELEGRAMS", "caatsm")
+			return err
+		}).Should(Succeed())
+		Expect(consumer.Config.AckPolicy).To(Equal(nc.AckExplicitPolicy))
+		Expect(consumer.Config.MaxDeliver).To(Equal(3))
+		Expect(consumer.Config.AckWait).To(Equal(time.Second))
+	})
+
+	It("should deliver the messages sent while the processor was down", func() {
+		first := NewSub(cfg)
+		Expect(first.js).NotTo(BeNil())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			first.Subscribe(cfg, handler)
+		}()
+		Eventually(func() error {
+			_, err := js.ConsumerInfo("TELEGRAMS", "caatsm")
+			return err
+		}).Should(Succeed())
+		first.Close()
+		Eventually(done).Should(BeClosed())
+
+		for i := 1; i <= 3; i++ {
+			_, err := js.Publish("Telegram.Serial", []byte(fmt.Sprintf("telegram %d", i)))
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		startSubscriber(cfg, handler)
+		Expect(receive(handler, 3)).To(ConsistOf("telegram 1", "telegram 2", "telegram 3"))
+		Eventually(func() int {
+			consumer, err := js.ConsumerInfo("TELEGRAMS", "caatsm")
+			Expect(err).NotTo(HaveOccurred())
+			return consumer.NumAckPending + int(consumer.NumPending)
+		}).Should(BeZero())
+	})
+
+	It("should redeliver a message whose handling failed", func() {
+		handler.failures["telegram"] = 1
+		startSubscriber(cfg, handler)
+		Eventually(func() error {
+			_, err := js.ConsumerInfo("TELEGRAMS", "caatsm")
+			return err
+		}).Should(Succeed())
+
+		_, err := js.Publish("Telegram.Serial", []byte("telegram"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(receive(handler, 2)).To(Equal([]string{"telegram", "telegram"}))
+	})
+
+	It("should publish into the stream", func() {
+		publisher := NewPub(cfg)
+		DeferCleanup(publisher.Close)
+		Expect(publisher.Publish(map[string]string{"category": "ARR"})).To(Succeed())
+
+		stream, err := js.StreamInfo("TELEGRAMS")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stream.State.Msgs).To(Equal(uint64(1)))
+	})
+
+	It("should drop a message published again with the same id", func() {
+		publisher := NewPub(cfg)
+		DeferCleanup(publisher.Close)
+		Expect(publisher.PublishWithID(context.Background(), "Telegram.Json", "record-1", "telegram")).To(Succeed())
+		Expect(publisher.PublishWithID(context.Background(), "Telegram.Json", "record-1", "telegram")).To(Succeed())
+
+		stream, err := js.StreamInfo("TELEGRAMS")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stream.State.Msgs).To(Equal(uint64(1)))
+	})
+
+	It("should cover the subjects rendered from the template", func() {
+		cfg.Publisher.SubjectTemplate = "Telegram.{category}.{dep}"
+		Expect(streamSubjects(cfg)).To(ConsistOf("Telegram.*.*", "Telegram.Serial", "Telegram.Json", "Telegram.Schedule", "Telegram.DeadLetter"))
+		cfg.Publisher.SubjectTemplate = "Telegram.{category}"
+		Expect(streamSubjects(cfg)).To(ConsistOf("Telegram.*"))
+
+		cfg.Publisher.SubjectTemplate = "Telegram.Json.{category}.{originator}"
+		publisher := NewPub(cfg)
+		DeferCleanup(publisher.Close)
+		published := testutil.ToFloat64(metrics.Published.WithLabelValues(cfg.Publisher.SubjectTemplate))
+		Expect(publisher.Publish(&domain.ParsedMessage{Category: "ARR", Originator: "ZSHCZTZX", Parsed: true})).To(Succeed())
+		Expect(testutil.ToFloat64(metrics.Published.WithLabelValues(cfg.Publisher.SubjectTemplate))).To(Equal(published + 1))
+
+		stream, err := js.StreamInfo("TELEGRAMS", &nc.StreamInfoRequest{SubjectsFilter: "Telegram.Json.ARR.>"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stream.State.Subjects).To(HaveKeyWithValue("Telegram.Json.ARR.ZSHCZTZX", uint64(1)))
+	})
+
+	It("should publish an outbox record with its headers", func() {
+		publisher := NewPub(cfg)
+		DeferCleanup(publisher.Close)
+		record := domain.OutboxRecord{
+			Uuid:    "record-3",
+			Topic:   "Telegram.Json",
+			Payload: []byte(`{"category":"ARR"}`),
+			Headers: map[string]string{"ce-type": "caatsm.telegram.arr", "ce-id": "telegram-1"},
+		}
+		Expect(publisher.PublishRecord(context.Background(), record)).To(Succeed())
+
+		msg, err := js.GetMsg("TELEGRAMS", 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(msg.Header.Get("ce-type")).To(Equal("caatsm.telegram.arr"))
+		Expect(msg.Header.Get("Nats-Msg-Id")).To(Equal("record-3"))
+		Expect(string(msg.Data)).To(Equal(`{"category":"ARR"}`))
+	})
+
+	It("should publish an outbox record as protobuf", func() {
+		cfg.Publisher.Format = FormatProtobuf
+		publisher := NewPub(cfg)
+		DeferCleanup(publisher.Close)
+		record := domain.OutboxRecord{
+			Uuid:    "record-4",
+			Topic:   "Telegram.Json",
+			Payload: []byte(`{"uuid":"telegram-1","category":"ARR","parsed":true,"bodyData":{"category":"ARR","aircraftId":"CES5470"}}`),
+			Headers: map[string]string{"ce-type": "caatsm.telegram.arr", "ce-dataschema": "urn:caatsm:schema:parsed-message/1.0.0"},
+		}
+		Expect(publisher.PublishRecord(context.Background(), record)).To(Succeed())
+
+		msg, err := js.GetMsg("TELEGRAMS", 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(msg.Header.Get("Content-Type")).To(Equal("application/protobuf; proto=caatsm.v1.ParsedMessage"))
+		Expect(msg.Header.Get("ce-type")).To(Equal("caatsm.telegram.arr"))
+		Expect(msg.Header.Get("ce-dataschema")).To(Equal("urn:caatsm:proto:caatsm.v1.ParsedMessage"))
+		message := &pb.ParsedMessage{}
+		Expect(proto.Unmarshal(msg.Data, message)).To(Succeed())
+		Expect(message.GetUuid()).To(Equal("telegram-1"))
+		Expect(message.GetArr().GetAircraftId()).To(Equal("CES5470"))
+	})
+
+	It("should send the trace context in the message headers", func() {
+		publisher := NewPub(cfg)
+		DeferCleanup(publisher.Close)
+		ctx := tracing.Extract(context.Background(), map[string]string{
+			"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		})
+		Expect(publisher.PublishWithID(ctx, "Telegram.Json", "record-2", "telegram")).To(Succeed())
+
+		msg, err := js.GetMsg("TELEGRAMS", 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(msg.Header.Get("traceparent")).To(ContainSubstring("4bf92f3577b34da6a3ce929d0e0e4736"))
+	})
+})
+
+var _ = Describe("Core NATS", func() {
+	It("should fall back to core NATS when the server has no JetStream", func() {
+		srv := runServer(false)
+		cfg := testConfig(srv.ClientURL(), true)
+		handler := newChannelHandler()
+		subscriber := startSubscriber(cfg, handler)
+		Expect(subscriber.js).To(BeNil())
+
+		conn, err := nc.Connect(srv.ClientURL())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+		Eventually(func() string {
+			if err := conn.Publish("Telegram.Serial", []byte("telegram")); err != nil {
+				return ""
+			}
+			select {
+			case payload := <-handler.handled:
+				return payload
+			case <-time.After(100 * time.Millisecond):
+				return ""
+			}
+		}).Should(Equal("telegram"))
+	})
+})
@@ -0,0 +1,164 @@
+package nats
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/iface"
+	"caatsm/pkg/utils"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+type delivery struct {
+	msg      *message.Message
+	progress func()
+	done     func(err error)
+}
+
+// WorkerPool handles messages on a fixed number of workers. Messages with the
+// same key, the originator address, always go to the same worker, so they are
+// handled in the order they were received.
+type WorkerPool struct {
+	handler iface.MessageHandler
+	queues  []chan delivery
+	next    atomic.Uint32
+	pending atomic.Int64
+	handled atomic.Int64
+	busy    atomic.Int64
+	wg      sync.WaitGroup
+	mu      sync.RWMutex
+	stopped bool
+}
+
+func NewWorkerPool(handler iface.MessageHandler, workers int) *WorkerPool {
+	if workers < 1 {
+		workers = 1
+	}
+	queues := make([]chan delivery, workers)
+	for i := range queues {
+		queues[i] = make(chan delivery, config.WorkerQueueSize)
+	}
+	return &WorkerPool{
+		handler: handler,
+		queues:  queues,
+	}
+}
+
+// Start runs the workers until Stop is called
+func (pool *WorkerPool) Start() {
+	for _, queue := range pool.queues {
+		pool.wg.Add(1)
+		go pool.work(queue)
+	}
+}
+
+// Dispatch queues a message on the worker of its key, blocking while that worker
+// is busy. Messages without a key are spread over the workers in turn. progress
+// is called once the message is queued and again when a worker picks it up, and
+// done with the result of the handler once the message is handled; either may
+// be nil. It returns false when the pool is stopped and the message was not queued.
+func (pool *WorkerPool) Dispatch(key string, msg *message.Message, progress func(), done func(err error)) bool {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+	if pool.stopped {
+		return false
+	}
+	if pool.pending.Add(1) == 1 {
+		pool.busy.Store(time.Now().UnixNano())
+	}
+	pool.queues[pool.worker(key)] <- delivery{msg: msg, progress: progress, done: done}
+	if progress != nil {
+		progress()
+	}
+	return true
+}
+
+// Stop waits for the queued messages to be handled. Messages dispatched afterwards are refused.
+func (pool *WorkerPool) Stop() {
+	pool.close()
+	pool.wg.Wait()
+}
+
+// StopWithin is Stop waiting at most timeout. It returns false when messages
+// were still being handled at the timeout.
+func (pool *WorkerPool) StopWithin(timeout time.Duration) bool {
+	stopped := make(chan struct{})
+	go func() {
+		// closing waits for a Dispatch blocked on a busy worker
+		pool.close()
+		pool.wg.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// Pending returns the number of messages queued or being handled
+func (pool *WorkerPool) Pending() int {
+	return int(pool.pending.Load())
+}
+
+// LastHandled returns when the last message was handled, zero before the first one
+func (pool *WorkerPool) LastHandled() time.Time {
+	if handled := pool.handled.Load(); handled != 0 {
+		return time.Unix(0, handled)
+	}
+	return time.Time{}
+}
+
+// BacklogSince returns when the pool last went from no pending message to
+// some, zero before the first message
+func (pool *WorkerPool) BacklogSince() time.Time {
+	if busy := pool.busy.Load(); busy != 0 {
+		return time.Unix(0, busy)
+	}
+	return time.Time{}
+}
+
+func (pool *WorkerPool) close() {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if !pool.stopped {
+		pool.stopped = true
+		for _, queue := range pool.queues {
+			close(queue)
+		}
+	}
+}
+
+func (pool *WorkerPool) worker(key string) int {
+	if key == "" {
+		return int(pool.next.Add(1) % uint32(len(pool.queues)))
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+	return int(hash.Sum32() % uint32(len(pool.queues)))
+}
+
+func (pool *WorkerPool) work(queue chan delivery) {
+	defer pool.wg.Done()
+	logger := utils.GetSugaredLogger()
+	for d := range queue {
+		if d.progress != nil {
+			d.progress()
+		}
+		err := pool.handler.HandleMessage(d.msg.Context(), d.msg.Payload, d.msg.UUID)
+		if err == nil {
+			logger.Infof("Message handled: %s", d.msg.UUID)
+		} else {
+			logger.Errorf("Failed to handle message [%s]: %v", d.msg.UUID, err)
+		}
+		if d.done != nil {
+			d.done(err)
+		}
+		pool.handled.Store(time.Now().UnixNano())
+		pool.pending.Add(-1)
+	}
+}
@@ -0,0 +1,106 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// recordingHandler records the order of the payloads handled per originator
+type recordingHandler struct {
+	mu       sync.Mutex
+	handled  map[string][]string
+	inFlight int
+	peak     int
+}
+
+func (h *recordingHandler) HandleMessage(ctx context.Context, msg []byte, id string) error {
+	h.mu.Lock()
+	h.inFlight++
+	h.peak = max(h.peak, h.inFlight)
+	h.mu.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.inFlight--
+	originator := strings.Fields(string(msg))[0]
+	h.handled[originator] = append(h.handled[originator], string(msg))
+	if strings.HasSuffix(string(msg), "fail") {
+		return fmt.Errorf("failed %s", id)
+	}
+	return nil
+}
+
+var _ = Describe("WorkerPool", func() {
+	var handler *recordingHandler
+
+	BeforeEach(func() {
+		handler = &recordingHandler{handled: make(map[string][]string)}
+	})
+
+	It("should handle messages in parallel and in order per originator", func() {
+		pool := NewWorkerPool(handler, 4)
+		pool.Start()
+		originators := []string{"ZBAAZPZX", "ZSSSZPZX", "ZGGGZPZX", "ZUUUZPZX", "ZBTJZPZX"}
+		for i := 0; i < 20; i++ {
+			for _, originator := range originators {
+				payload := fmt.Sprintf("%s %02d", originator, i)
+				pool.Dispatch(originator, message.NewMessage(payload, []byte(payload)), nil, nil)
+			}
+		}
+		pool.Stop()
+
+		Expect(handler.handled).To(HaveLen(len(originators)))
+		for _, originator := range originators {
+			received := handler.handled[originator]
+			Expect(received).To(HaveLen(20))
+			for i, payload := range received {
+				Expect(payload).To(Equal(fmt.Sprintf("%s %02d", originator, i)))
+			}
+		}
+		Expect(handler.peak).To(BeNumerically(">", 1))
+	})
+
+	It("should report the handler result", func() {
+		pool := NewWorkerPool(handler, 0)
+		pool.Start()
+		var mu sync.Mutex
+		results := make(map[string]error)
+		for _, payload := range []string{"ZBAAZPZX ok", "ZBAAZPZX fail"} {
+			pool.Dispatch("", message.NewMessage(payload, []byte(payload)), nil, func(err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				results[payload] = err
+			})
+		}
+		pool.Stop()
+
+		Expect(results).To(HaveLen(2))
+		Expect(results["ZBAAZPZX ok"]).NotTo(HaveOccurred())
+		Expect(results["ZBAAZPZX fail"]).To(HaveOccurred())
+	})
+
+	It("should report progress when a message is queued and when it is picked up", func() {
+		pool := NewWorkerPool(handler, 2)
+		pool.Start()
+		Expect(pool.BacklogSince().IsZero()).To(BeTrue())
+		var progress atomic.Int32
+		for i := 0; i < 5; i++ {
+			payload := fmt.Sprintf("ZBAAZPZX %02d", i)
+			pool.Dispatch("ZBAAZPZX", message.NewMessage(payload, []byte(payload)), func() { progress.Add(1) }, nil)
+		}
+		pool.Stop()
+
+		Expect(progress.Load()).To(Equal(int32(10)))
+		Expect(pool.BacklogSince().IsZero()).To(BeFalse())
+	})
+})
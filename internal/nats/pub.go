@@ -2,50 +2,138 @@ package nats
 
 import (
 	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"caatsm/internal/metrics"
+	"caatsm/internal/tracing"
 	"caatsm/pkg/utils"
+	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill-nats/v2/pkg/nats"
 	"github.com/ThreeDotsLabs/watermill/message"
 	nc "github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type NatsPublisher struct {
-	config    *config.Config
-	publisher *nats.Publisher
+	config     *config.Config
+	publisher  *nats.Publisher
+	subject    *domain.SubjectTemplate
+	serializer Serializer
 }
 
 func NewPub(config *config.Config) *NatsPublisher {
-	logger := watermill.NewStdLogger(false, false)
-
-	jsConfig := nats.JetStreamConfig{Disabled: true}
-	options := []nc.Option{
-		nc.RetryOnFailedConnect(true),
-		nc.Timeout(config.Timeouts.Server),
-		nc.ReconnectWait(config.Timeouts.ReconnectWait),
-	}
-	publisher, _ := nats.NewPublisher(
-		nats.PublisherConfig{
-			URL:         config.Nats.URL,
-			NatsOptions: options,
-			JetStream:   jsConfig,
-		}, logger)
+	logger := utils.GetSugaredLogger()
+	conn, err := connect(config)
+	if err != nil {
+		logger.Errorf("Failed to connect to nats: %v", err)
+		return &NatsPublisher{config: config, subject: subjectTemplate(config), serializer: serializer(config)}
+	}
+	js, err := jetStream(conn, config)
+	if err != nil {
+		logger.Errorf("Failed to set up JetStream, falling back to core NATS: %v", err)
+	}
+	return newPublisher(config, conn, js == nil)
+}
+
+// newPublisher publishes on an open connection, with JetStream unless disabled
+func newPublisher(config *config.Config, conn *nc.Conn, jetStreamDisabled bool) *NatsPublisher {
+	jsConfig := nats.JetStreamConfig{Disabled: jetStreamDisabled, TrackMsgId: true}
+	publisher, err := nats.NewPublisherWithNatsConn(conn, nats.PublisherPublishConfig{
+		Marshaler:         &nats.NATSMarshaler{},
+		SubjectCalculator: nats.DefaultSubjectCalculator,
+		JetStream:         jsConfig,
+	}, watermill.NewStdLogger(false, false))
+	if err != nil {
+		utils.GetSugaredLogger().Errorf("Failed to create publisher: %v", err)
+	}
 	return &NatsPublisher{
-		config:    config,
-		publisher: publisher,
+		config:     config,
+		publisher:  publisher,
+		subject:    subjectTemplate(config),
+		serializer: serializer(config),
 	}
 }
 
+// Publish sends a message to the publisher topic, or to the subject rendered
+// from the template for a parsed message
 func (n *NatsPublisher) Publish(parsedMessage interface{}) error {
-	logger := utils.GetSugaredLogger()
+	topic := n.config.Publisher.Topic
+	if message, ok := parsedMessage.(*domain.ParsedMessage); ok {
+		topic = subject(n.subject, topic, message)
+	}
+	return n.PublishTo(topic, parsedMessage)
+}
+
+// PublishTo sends a message to the given topic
+func (n *NatsPublisher) PublishTo(topic string, parsedMessage interface{}) error {
+	return n.PublishWithID(context.Background(), topic, watermill.NewUUID(), parsedMessage)
+}
 
-	messageText, err := json.Marshal(parsedMessage)
+// PublishWithID sends a message to the given topic with the given id. With
+// JetStream the id is the Nats-Msg-Id header, so the stream drops the message
+// when the same id was published within its duplicate window.
+// The message is encoded in the configured format and the trace context of ctx
+// is sent in the message headers.
+func (n *NatsPublisher) PublishWithID(ctx context.Context, topic, id string, parsedMessage interface{}) error {
+	payload, contentType, err := n.serializer.Marshal(parsedMessage)
 	if err != nil {
-		logger.Errorf("Failed to marshal message: %v", err)
+		utils.GetSugaredLogger().Errorf("Failed to marshal message: %v", err)
+		return err
+	}
+	return n.publish(ctx, topic, id, payload, contentHeaders(nil, contentType))
+}
+
+// PublishRecord sends an outbox record with its id and headers. The outbox
+// keeps the JSON encoding, so in another format the record is decoded and
+// encoded again: as a Schedule on the schedule topic, a ParsedMessage otherwise.
+// Dead letters are always published as JSON.
+func (n *NatsPublisher) PublishRecord(ctx context.Context, record domain.OutboxRecord) error {
+	if _, ok := n.serializer.(JSONSerializer); ok || record.Topic == n.config.Publisher.DeadLetterTopic {
+		return n.publish(ctx, record.Topic, record.Uuid, record.Payload, record.Headers)
 	}
-	msg := message.NewMessage(watermill.NewUUID(), []byte(messageText))
-	err = n.publisher.Publish(n.config.Publisher.Topic, msg)
+	var message interface{}
+	var err error
+	if record.Topic == n.config.Publisher.ScheduleTopic {
+		schedule := &domain.Schedule{}
+		err = json.Unmarshal(record.Payload, schedule)
+		message = schedule
+	} else {
+		message, err = domain.DecodeParsedMessage(record.Payload)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode outbox record %s: %v", record.Uuid, err)
+	}
+	payload, contentType, err := n.serializer.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return n.publish(ctx, record.Topic, record.Uuid, payload, contentHeaders(record.Headers, contentType))
+}
+
+func (n *NatsPublisher) publish(ctx context.Context, topic, id string, payload []byte, headers map[string]string) (err error) {
+	logger := utils.GetSugaredLogger()
+	ctx, span := tracing.Start(ctx, "nats.publish",
+		attribute.String("messaging.destination.name", topic), attribute.String("messaging.message.id", id))
+	defer func() { tracing.End(span, err) }()
+	if n.publisher == nil {
+		err = fmt.Errorf("publisher is not connected")
+		metrics.ObservePublish(metricTopic(n.subject, topic), err)
+		return err
+	}
+
+	msg := message.NewMessage(id, payload)
+	msg.SetContext(ctx)
+	for key, value := range headers {
+		msg.Metadata.Set(key, value)
+	}
+	for key, value := range tracing.Inject(ctx) {
+		msg.Metadata.Set(key, value)
+	}
+	err = n.publisher.Publish(topic, msg)
+	metrics.ObservePublish(metricTopic(n.subject, topic), err)
 	if err != nil {
 		logger.Errorf("Failed to publish message: %v", err)
 		return err
@@ -53,3 +141,11 @@ func (n *NatsPublisher) Publish(parsedMessage interface{}) error {
 	logger.Infof("Message published: %s", msg.UUID)
 	return nil
 }
+
+// Close closes the publisher connection
+func (n *NatsPublisher) Close() error {
+	if n.publisher == nil {
+		return nil
+	}
+	return n.publisher.Close()
+}
@@ -0,0 +1,75 @@
+package nats
+
+import (
+	"fmt"
+	"time"
+
+	nc "github.com/nats-io/nats.go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Queue group", func() {
+	// waitForInterest publishes probes until both subscribers have received one
+	waitForInterest := func(conn *nc.Conn, first, second *channelHandler) {
+		seen := make(map[*channelHandler]bool)
+		Eventually(func() int {
+			Expect(conn.Publish("Telegram.Serial", []byte("probe"))).To(Succeed())
+			time.Sleep(10 * time.Millisecond)
+			for _, handler := range []*channelHandler{first, second} {
+				for len(handler.handled) > 0 {
+					<-handler.handled
+					seen[handler] = true
+				}
+			}
+			return len(seen)
+		}, 5*time.Second).Should(Equal(2))
+	}
+
+	share := func(jetStream bool) {
+		srv := runServer(jetStream)
+		cfg := testConfig(srv.ClientURL(), jetStream)
+		cfg.Subscription.QueueGroup = "tele-queue"
+		first, second := newChannelHandler(), newChannelHandler()
+		startSubscriber(cfg, first)
+		startSubscriber(cfg, second)
+
+		conn, err := nc.Connect(srv.ClientURL())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+		waitForInterest(conn, first, second)
+
+		for i := 0; i < 50; i++ {
+			Expect(conn.Publish("Telegram.Serial", []byte(fmt.Sprintf("telegram %d", i)))).To(Succeed())
+		}
+		received := make(map[string]int)
+		counts := make(map[*channelHandler]int)
+		Eventually(func() int {
+			for _, handler := range []*channelHandler{first, second} {
+				for len(handler.handled) > 0 {
+					payload := <-handler.handled
+					if payload != "probe" {
+						received[payload]++
+						counts[handler]++
+					}
+				}
+			}
+			return len(received)
+		}, 5*time.Second).Should(Equal(50))
+
+		Consistently(func() int { return len(first.handled) + len(second.handled) }, 200*time.Millisecond).Should(BeZero())
+		for payload, count := range received {
+			Expect(count).To(Equal(1), payload)
+		}
+		Expect(counts[first]).To(BeNumerically(">", 0))
+		Expect(counts[second]).To(BeNumerically(">", 0))
+	}
+
+	It("should share the messages between two core NATS subscribers", func() {
+		share(false)
+	})
+
+	It("should share the messages of the durable consumer between two subscribers", func() {
+		share(true)
+	})
+})
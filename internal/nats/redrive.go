@@ -0,0 +1,127 @@
+package nats
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"caatsm/pkg/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	nc "github.com/nats-io/nats.go"
+)
+
+const (
+	// redriveBatch is the number of dead letters fetched at a time
+	redriveBatch = 50
+	// redriveWait is how long to wait for more dead letters before stopping
+	redriveWait = time.Second
+)
+
+// Redrive sends the payloads of the dead letters back to the subscription topic,
+// at most limit of them when limit is positive, and returns how many were sent.
+// A dead letter of the publishing stage holds an outbox record, which is
+// published again on its own topic instead.
+// Dead letters are only kept with JetStream; a durable consumer named after the
+// subscription durable remembers which ones were already re-driven. Only the dead
+// letters stored before the call are re-driven, so a message rejected again is
+// left for the next run.
+func Redrive(cfg *config.Config, limit int) (int, error) {
+	logger := utils.GetSugaredLogger()
+	conn, err := connect(cfg)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	js, err := jetStream(conn, cfg)
+	if err != nil {
+		return 0, err
+	}
+	if js == nil {
+		return 0, fmt.Errorf("re-driving needs JetStream, dead letters are not kept with core NATS")
+	}
+
+	stream, err := js.StreamInfo(cfg.JetStream.Stream)
+	if err != nil {
+		return 0, err
+	}
+	last := stream.State.LastSeq
+
+	durable := cfg.JetStream.Durable + "-redrive"
+	if _, err := js.ConsumerInfo(cfg.JetStream.Stream, durable); errors.Is(err, nc.ErrConsumerNotFound) {
+		_, err = js.AddConsumer(cfg.JetStream.Stream, &nc.ConsumerConfig{
+			Durable:       durable,
+			FilterSubject: cfg.Publisher.DeadLetterTopic,
+			AckPolicy:     nc.AckExplicitPolicy,
+		})
+		if err != nil {
+			return 0, err
+		}
+	} else if err != nil {
+		return 0, err
+	}
+	sub, err := js.PullSubscribe(cfg.Publisher.DeadLetterTopic, durable, nc.Bind(cfg.JetStream.Stream, durable))
+	if err != nil {
+		return 0, err
+	}
+	defer sub.Unsubscribe()
+	publisher := newPublisher(cfg, conn, false)
+
+	count := 0
+	for limit <= 0 || count < limit {
+		batch := redriveBatch
+		if limit > 0 {
+			batch = min(batch, limit-count)
+		}
+		messages, err := sub.Fetch(batch, nc.MaxWait(redriveWait))
+		if errors.Is(err, nc.ErrTimeout) {
+			break
+		}
+		if err != nil {
+			return count, err
+		}
+		for _, msg := range messages {
+			if metadata, err := msg.Metadata(); err == nil && metadata.Sequence.Stream > last {
+				msg.Nak()
+				return count, nil
+			}
+			var letter domain.DeadLetter
+			if err := json.Unmarshal(msg.Data, &letter); err != nil {
+				logger.Warnf("Skipping invalid dead letter: %v", err)
+				msg.Term()
+				continue
+			}
+			if letter.Stage == domain.StagePublishing && letter.Topic == "" {
+				logger.Warnf("Skipping dead letter [%s] rejected at %s without its topic", letter.Uuid, letter.Stage)
+				msg.Term()
+				continue
+			}
+			if err := redrive(publisher, js, cfg, letter); err != nil {
+				msg.Nak()
+				return count, err
+			}
+			if err := msg.Ack(); err != nil {
+				return count, err
+			}
+			logger.Infof("Re-drove message [%s] rejected at %s: %s", letter.Uuid, letter.Stage, letter.Reason)
+			count++
+		}
+	}
+	return count, nil
+}
+
+// redrive publishes an outbox record again on its topic, and a telegram on the subscription topic
+func redrive(publisher *NatsPublisher, js nc.JetStreamContext, cfg *config.Config, letter domain.DeadLetter) error {
+	if letter.Stage != domain.StagePublishing {
+		_, err := js.Publish(cfg.Subscription.Topic, []byte(letter.Payload))
+		return err
+	}
+	return publisher.PublishRecord(context.Background(), domain.OutboxRecord{
+		Uuid:    letter.Uuid,
+		Topic:   letter.Topic,
+		Payload: json.RawMessage(letter.Payload),
+		Headers: letter.Headers,
+	})
+}
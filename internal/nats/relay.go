@@ -0,0 +1,182 @@
+package nats
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"caatsm/internal/iface"
+	"caatsm/internal/metrics"
+	"caatsm/internal/tracing"
+	"caatsm/pkg/utils"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxRelay publishes the records saved in the outbox and marks them sent.
+// Records are published in the order they were saved and each one keeps its id,
+// so a record published again after a crash is dropped by the JetStream
+// duplicate window. Each record is published in the trace of the message it
+// was saved for.
+//
+// The relay claims the records it publishes for the configured lease, so the
+// relays of several instances do not publish the same records. A record that
+// still fails after the configured attempts is sent to the dead-letter topic,
+// so it does not hold back the records after it.
+type OutboxRelay struct {
+	config     *config.Config
+	repository iface.OutboxRepository
+	publisher  iface.MessagePublisher
+	owner      string
+	stop       chan struct{}
+	done       chan struct{}
+	once       sync.Once
+}
+
+func NewRelay(config *config.Config, publisher iface.MessagePublisher, repository iface.OutboxRepository) *OutboxRelay {
+	return &OutboxRelay{
+		config:     config,
+		repository: repository,
+		publisher:  publisher,
+		owner:      uuid.NewString(),
+		stop:       make(chan struct{}),
+	}
+}
+
+// Start relays the outbox at the configured interval until Stop is called
+func (relay *OutboxRelay) Start() {
+	interval := relay.config.Outbox.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	relay.done = make(chan struct{})
+	go func() {
+		defer close(relay.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-relay.stop:
+				return
+			case <-ticker.C:
+				if _, err := relay.Flush(); err != nil {
+					utils.GetSugaredLogger().Errorf("Failed to relay the outbox: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop ends the relay, waiting for the records being published
+func (relay *OutboxRelay) Stop() {
+	relay.once.Do(func() { close(relay.stop) })
+	if relay.done != nil {
+		<-relay.done
+	}
+}
+
+// Flush publishes the pending records until the outbox is empty and returns
+// how many were sent. It stops at the first record that cannot be published,
+// so the records after it are not sent out of order, and gives back the ones it
+// claimed after it.
+func (relay *OutboxRelay) Flush() (int, error) {
+	batch := relay.config.Outbox.Batch
+	if batch <= 0 {
+		batch = 100
+	}
+	lease := relay.config.Outbox.Lease
+	if lease <= 0 {
+		lease = 30 * time.Second
+	}
+	count := 0
+	for {
+		records, err := relay.repository.ClaimOutbox(relay.owner, batch, lease)
+		if err != nil {
+			return count, err
+		}
+		sent := make([]string, 0, len(records))
+		var publishErr error
+		for i, record := range records {
+			publishErr = retry(relay.config.Retry.Publisher, "publish outbox record "+record.Uuid, func() error {
+				ctx := tracing.Extract(context.Background(), record.TraceContext)
+				return relay.publisher.PublishRecord(ctx, record)
+			})
+			if publishErr == nil {
+				sent = append(sent, record.Uuid)
+				continue
+			}
+			if relay.fail(record, publishErr) {
+				publishErr = nil
+				continue
+			}
+			relay.release(records[i+1:])
+			break
+		}
+		if len(sent) > 0 {
+			err = retry(relay.config.Retry.Repository, "mark outbox records sent", func() error {
+				return relay.repository.MarkSent(sent)
+			})
+			if err != nil {
+				return count, err
+			}
+			count += len(sent)
+		}
+		if publishErr != nil {
+			return count, publishErr
+		}
+		if len(records) < batch {
+			return count, nil
+		}
+	}
+}
+
+// fail records a failed attempt to publish a record. After the last attempt
+// the record is sent to the dead-letter topic, and fail returns true once it is.
+func (relay *OutboxRelay) fail(record domain.OutboxRecord, err error) bool {
+	logger := utils.GetSugaredLogger()
+	attempts := record.Attempts + 1
+	failed := relay.config.Outbox.MaxAttempts > 0 && attempts >= relay.config.Outbox.MaxAttempts
+	if failed {
+		if deadErr := relay.deadLetter(record, err, attempts); deadErr != nil {
+			logger.Errorf("Failed to dead-letter outbox record %s: %v", record.Uuid, deadErr)
+			failed = false
+		}
+	}
+	if recordErr := relay.repository.RecordFailure(record.Uuid, attempts, err.Error(), failed); recordErr != nil {
+		logger.Errorf("Failed to record the attempt %d of outbox record %s: %v", attempts, record.Uuid, recordErr)
+	}
+	return failed
+}
+
+// deadLetter publishes a record that cannot be published on the dead-letter topic
+func (relay *OutboxRelay) deadLetter(record domain.OutboxRecord, err error, attempts int) error {
+	topic := relay.config.Publisher.DeadLetterTopic
+	deadLetter := domain.NewDeadLetter(record.Uuid, record.Payload, domain.StagePublishing, err.Error(), attempts)
+	deadLetter.Topic = record.Topic
+	deadLetter.Headers = record.Headers
+	letter, marshalErr := domain.NewOutboxRecord(record.Uuid, topic, deadLetter)
+	if marshalErr != nil {
+		return marshalErr
+	}
+	utils.GetSugaredLogger().Warnf("Dead-lettering outbox record %s for %s after %d attempts: %v", record.Uuid, record.Topic, attempts, err)
+	if publishErr := relay.publisher.PublishRecord(context.Background(), *letter); publishErr != nil {
+		return publishErr
+	}
+	metrics.DeadLetters.WithLabelValues(domain.StagePublishing).Inc()
+	return nil
+}
+
+// release gives back the records claimed but not tried, for the next flush
+func (relay *OutboxRelay) release(records []domain.OutboxRecord) {
+	if len(records) == 0 {
+		return
+	}
+	uuids := make([]string, 0, len(records))
+	for _, record := range records {
+		uuids = append(uuids, record.Uuid)
+	}
+	if err := relay.repository.ReleaseOutbox(relay.owner, uuids); err != nil {
+		utils.GetSugaredLogger().Warnf("Failed to release %d outbox records: %v", len(uuids), err)
+	}
+}
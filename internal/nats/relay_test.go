@@ -0,0 +1,121 @@
+package nats
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutboxRelay", func() {
+	var (
+		cfg        *config.Config
+		publisher  *fakePublisher
+		repository *fakeRepository
+		relay      *OutboxRelay
+	)
+
+	record := func(topic, payload string) domain.OutboxRecord {
+		record, err := domain.NewOutboxRecord(payload, topic, payload)
+		Expect(err).NotTo(HaveOccurred())
+		return *record
+	}
+
+	BeforeEach(func() {
+		sleep = func(time.Duration) {}
+		DeferCleanup(func() { sleep = time.Sleep })
+		cfg = &config.Config{Outbox: config.OutboxConfig{Interval: 10 * time.Millisecond, Batch: 2}}
+		publisher = &fakePublisher{}
+		repository = newFakeRepository()
+		relay = NewRelay(cfg, publisher, repository)
+	})
+
+	It("should publish the pending records in order with their ids and mark them sent", func() {
+		repository.outbox = []domain.OutboxRecord{
+			record("Telegram.Json", "first"), record("Telegram.Schedule", "second"), record("Telegram.Json", "third"),
+		}
+		count, err := relay.Flush()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(3))
+		Expect(publisher.messages).To(HaveLen(3))
+		for i, message := range publisher.messages {
+			Expect(message.topic).To(Equal(repository.outbox[i].Topic))
+			Expect(message.id).To(Equal(repository.outbox[i].Uuid))
+			Expect(repository.sent).To(HaveKey(message.id))
+		}
+		Expect(publisher.messages[1].message).To(BeEquivalentTo(`"second"`))
+	})
+
+	It("should publish the headers saved with a record", func() {
+		saved := record("Telegram.Json", "event")
+		saved.Headers = map[string]string{"ce-type": "caatsm.telegram.arr"}
+		repository.outbox = []domain.OutboxRecord{saved}
+		_, err := relay.Flush()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(publisher.messages[0].headers).To(HaveKeyWithValue("ce-type", "caatsm.telegram.arr"))
+	})
+
+	It("should keep the records it could not publish for the next flush", func() {
+		cfg.Retry.Publisher = config.RetryConfig{Attempts: 2}
+		repository.outbox = []domain.OutboxRecord{record("Telegram.Json", "first")}
+		publisher.failures = 2
+		_, err := relay.Flush()
+		Expect(err).To(MatchError(ContainSubstring("nats is down")))
+		Expect(repository.sent).To(BeEmpty())
+
+		count, err := relay.Flush()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(1))
+		Expect(repository.sent).To(HaveLen(1))
+	})
+
+	It("should relay in the background until stopped", func() {
+		repository.outbox = []domain.OutboxRecord{record("Telegram.Json", "first")}
+		relay.Start()
+		Eventually(publisher.count).Should(Equal(1))
+		relay.Stop()
+		Expect(repository.sent).To(HaveLen(1))
+	})
+
+	It("should dead-letter a record that fails on every attempt and publish the ones after it", func() {
+		cfg.Outbox.MaxAttempts = 2
+		cfg.Publisher.DeadLetterTopic = "Telegram.DeadLetter"
+		poison := record("Telegram.Json", "poison")
+		repository.outbox = []domain.OutboxRecord{poison, record("Telegram.Json", "next")}
+		publisher.failures = 1
+		_, err := relay.Flush()
+		Expect(err).To(MatchError(ContainSubstring("nats is down")))
+		Expect(repository.attempts).To(HaveKeyWithValue(poison.Uuid, 1))
+		Expect(repository.claims).To(BeEmpty())
+
+		publisher.failures = 1
+		count, err := relay.Flush()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(1))
+		Expect(repository.failed).To(HaveKeyWithValue(poison.Uuid, true))
+		Expect(publisher.messages).To(HaveLen(2))
+		Expect(publisher.messages[0].topic).To(Equal("Telegram.DeadLetter"))
+		Expect(publisher.messages[0].message).To(ContainSubstring(`"stage":"publishing"`))
+		Expect(publisher.messages[0].message).To(ContainSubstring(`"topic":"Telegram.Json"`))
+		Expect(publisher.messages[1].message).To(BeEquivalentTo(`"next"`))
+
+		count, err = relay.Flush()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(BeZero())
+	})
+
+	It("should not publish the records claimed by another relay", func() {
+		repository.outbox = []domain.OutboxRecord{record("Telegram.Json", "first"), record("Telegram.Json", "second")}
+		claimed, err := repository.ClaimOutbox("other", 1, time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(claimed).To(HaveLen(1))
+
+		count, err := relay.Flush()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(1))
+		Expect(publisher.messages).To(HaveLen(1))
+		Expect(publisher.messages[0].message).To(BeEquivalentTo(`"second"`))
+	})
+})
@@ -0,0 +1,38 @@
+package nats
+
+import (
+	"caatsm/internal/config"
+	"caatsm/pkg/utils"
+	"fmt"
+	"time"
+)
+
+// sleep waits between the attempts, replaced in tests
+var sleep = time.Sleep
+
+// SinkError reports a telegram that could not be written to a sink after all retries
+type SinkError struct {
+	Stage string
+	Err   error
+}
+
+func (e *SinkError) Error() string {
+	return fmt.Sprintf("failed at %s: %v", e.Stage, e.Err)
+}
+
+func (e *SinkError) Unwrap() error {
+	return e.Err
+}
+
+// retry calls op until it succeeds or the attempts of the policy are used up,
+// waiting with exponential backoff in between, and returns the last error.
+func retry(policy config.RetryConfig, name string, op func() error) error {
+	err := op()
+	for attempt := 1; err != nil && attempt < policy.Attempts; attempt++ {
+		interval := policy.Interval(attempt)
+		utils.GetSugaredLogger().Warnf("Failed to %s, retrying in %v (attempt %d of %d): %v", name, interval, attempt, policy.Attempts, err)
+		sleep(interval)
+		err = op()
+	}
+	return err
+}
@@ -0,0 +1,106 @@
+package nats
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"caatsm/internal/pb"
+	"caatsm/pkg/utils"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Publisher formats
+const (
+	FormatJSON     = "json"
+	FormatProtobuf = "protobuf"
+)
+
+// ProtobufContentType is followed by the full name of the message type in the Content-Type header
+const ProtobufContentType = "application/protobuf; proto="
+
+// dataSchemaHeader is the dataschema attribute of a binary CloudEvent
+const dataSchemaHeader = "ce-dataschema"
+
+// protoDataSchema is followed by the full name of the protobuf type in the
+// dataschema of a protobuf message, e.g. urn:caatsm:proto:caatsm.v1.Schedule
+const protoDataSchema = "urn:caatsm:proto:"
+
+// Serializer encodes the messages to publish. It returns the content type to
+// send in the Content-Type header, empty to send none.
+type Serializer interface {
+	Marshal(message interface{}) (payload []byte, contentType string, err error)
+}
+
+// serializer returns the serializer of the configured format, JSON when it is invalid
+func serializer(cfg *config.Config) Serializer {
+	s, err := NewSerializer(cfg.Publisher.Format)
+	if err != nil {
+		utils.GetSugaredLogger().Errorf("Publishing JSON: %v", err)
+		return JSONSerializer{}
+	}
+	return s
+}
+
+// contentHeaders returns the headers with the content type, when there is one.
+// The dataschema of a binary CloudEvent names the JSON schema of the outbox
+// record, so for a protobuf message it becomes a URN of the full name of its type.
+func contentHeaders(headers map[string]string, contentType string) map[string]string {
+	if contentType == "" {
+		return headers
+	}
+	merged := map[string]string{"Content-Type": contentType}
+	for key, value := range headers {
+		if key != "Content-Type" {
+			merged[key] = value
+		}
+	}
+	if _, ok := merged[dataSchemaHeader]; ok && strings.HasPrefix(contentType, ProtobufContentType) {
+		merged[dataSchemaHeader] = protoDataSchema + strings.TrimPrefix(contentType, ProtobufContentType)
+	}
+	return merged
+}
+
+// NewSerializer returns the serializer of a format, JSON when none is given
+func NewSerializer(format string) (Serializer, error) {
+	switch format {
+	case "", FormatJSON:
+		return JSONSerializer{}, nil
+	case FormatProtobuf:
+		return ProtobufSerializer{}, nil
+	}
+	return nil, fmt.Errorf("invalid publisher format: %s", format)
+}
+
+// JSONSerializer encodes messages as JSON, without a content type, as published before formats were added
+type JSONSerializer struct{}
+
+func (JSONSerializer) Marshal(message interface{}) ([]byte, string, error) {
+	payload, err := json.Marshal(message)
+	return payload, "", err
+}
+
+// ProtobufSerializer encodes parsed messages and schedules in their protobuf form
+type ProtobufSerializer struct{}
+
+func (ProtobufSerializer) Marshal(message interface{}) ([]byte, string, error) {
+	var m proto.Message
+	switch v := message.(type) {
+	case proto.Message:
+		m = v
+	case *domain.ParsedMessage:
+		m = pb.FromParsedMessage(v)
+	case domain.ParsedMessage:
+		m = pb.FromParsedMessage(&v)
+	case *domain.Schedule:
+		m = pb.FromSchedule(v)
+	case domain.Schedule:
+		m = pb.FromSchedule(&v)
+	default:
+		return nil, "", fmt.Errorf("cannot encode %T as protobuf", message)
+	}
+	payload, err := proto.Marshal(m)
+	return payload, ProtobufContentType + string(m.ProtoReflect().Descriptor().FullName()), err
+}
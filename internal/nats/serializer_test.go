@@ -0,0 +1,57 @@
+package nats
+
+import (
+	"caatsm/internal/domain"
+	"caatsm/internal/pb"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("Serializer", func() {
+	It("should encode JSON without a content type", func() {
+		s, err := NewSerializer(FormatJSON)
+		Expect(err).NotTo(HaveOccurred())
+		payload, contentType, err := s.Marshal(map[string]string{"category": "ARR"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(payload)).To(Equal(`{"category":"ARR"}`))
+		Expect(contentType).To(BeEmpty())
+	})
+
+	It("should encode parsed messages and schedules as protobuf", func() {
+		s, err := NewSerializer(FormatProtobuf)
+		Expect(err).NotTo(HaveOccurred())
+		payload, contentType, err := s.Marshal(&domain.ParsedMessage{Category: "CNL", BodyData: &domain.CNL{AircraftID: "CES5470"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(contentType).To(Equal("application/protobuf; proto=caatsm.v1.ParsedMessage"))
+		message := &pb.ParsedMessage{}
+		Expect(proto.Unmarshal(payload, message)).To(Succeed())
+		Expect(message.GetCnl().GetAircraftId()).To(Equal("CES5470"))
+
+		_, contentType, err = s.Marshal(domain.Schedule{Airline: "HU"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(contentType).To(Equal("application/protobuf; proto=caatsm.v1.Schedule"))
+	})
+
+	It("should return an error for a type without a protobuf form", func() {
+		_, _, err := ProtobufSerializer{}.Marshal("telegram")
+		Expect(err).To(MatchError("cannot encode string as protobuf"))
+		_, err = NewSerializer("avro")
+		Expect(err).To(MatchError("invalid publisher format: avro"))
+	})
+
+	It("should put the content type in the headers", func() {
+		Expect(contentHeaders(nil, "")).To(BeNil())
+		Expect(contentHeaders(map[string]string{"ce-id": "1", "Content-Type": "application/json"}, "application/protobuf")).
+			To(Equal(map[string]string{"ce-id": "1", "Content-Type": "application/protobuf"}))
+	})
+
+	It("should name the protobuf type in the dataschema URI of a binary CloudEvent", func() {
+		headers := map[string]string{"ce-id": "1", "ce-dataschema": "urn:caatsm:schema:parsed-message/1.0.0"}
+		Expect(contentHeaders(headers, ProtobufContentType+"caatsm.v1.ParsedMessage")).
+			To(HaveKeyWithValue("ce-dataschema", "urn:caatsm:proto:caatsm.v1.ParsedMessage"))
+		Expect(contentHeaders(map[string]string{"ce-id": "1"}, ProtobufContentType+"caatsm.v1.ParsedMessage")).
+			NotTo(HaveKey("ce-dataschema"))
+	})
+})
@@ -0,0 +1,113 @@
+package nats
+
+import (
+	"caatsm/internal/config"
+	"context"
+	"time"
+
+	nc "github.com/nats-io/nats.go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// blockingHandler holds each message until it is released
+type blockingHandler struct {
+	started chan string
+	release chan struct{}
+}
+
+func (h *blockingHandler) HandleMessage(ctx context.Context, msg []byte, id string) error {
+	h.started <- string(msg)
+	<-h.release
+	return nil
+}
+
+var _ = Describe("Shutdown", func() {
+	var (
+		cfg        *config.Config
+		js         nc.JetStreamContext
+		handler    *blockingHandler
+		subscriber *NatsSubscriber
+		done       chan struct{}
+	)
+
+	BeforeEach(func() {
+		srv := runServer(true)
+		cfg = testConfig(srv.ClientURL(), true)
+		cfg.Timeouts.AckWait = 10 * time.Second
+		conn, err := nc.Connect(srv.ClientURL())
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+		js, err = conn.JetStream()
+		Expect(err).NotTo(HaveOccurred())
+
+		handler = &blockingHandler{started: make(chan string, 10), release: make(chan struct{})}
+		subscriber = NewSub(cfg)
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			subscriber.Subscribe(cfg, handler)
+		}()
+		Eventually(func() error {
+			_, err := js.ConsumerInfo("TELEGRAMS", "caatsm")
+			return err
+		}).Should(Succeed())
+		_, err = js.Publish("Telegram.Serial", []byte("telegram"))
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(handler.started, 5*time.Second).Should(Receive(Equal("telegram")))
+	})
+
+	It("should finish the message in flight, ack it and keep the durable consumer", func() {
+		Expect(subscriber.Connected()).To(BeTrue())
+		Expect(subscriber.Backlog()).To(Equal(1))
+		Expect(subscriber.LastHandled().IsZero()).To(BeTrue())
+		result := make(chan error, 1)
+		go func() { result <- subscriber.Shutdown(5 * time.Second) }()
+		Consistently(result, 200*time.Millisecond).ShouldNot(Receive())
+
+		_, err := js.Publish("Telegram.Serial", []byte("late"))
+		Expect(err).NotTo(HaveOccurred())
+		close(handler.release)
+		Eventually(result).Should(Receive(BeNil()))
+		Eventually(done).Should(BeClosed())
+		Expect(subscriber.Connected()).To(BeFalse())
+		Expect(subscriber.Backlog()).To(BeZero())
+		Expect(subscriber.LastHandled().IsZero()).To(BeFalse())
+		Expect(handler.started).NotTo(Receive())
+
+		consumer, err := js.ConsumerInfo("TELEGRAMS", "caatsm")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(consumer.Delivered.Stream).To(BeNumerically(">=", 1))
+		Expect(consumer.AckFloor.Stream).To(BeNumerically(">=", 1))
+	})
+
+	It("should stop the deliveries while draining and keep the messages for the next start", func() {
+		result := make(chan error, 1)
+		go func() { result <- subscriber.Shutdown(5 * time.Second) }()
+		Eventually(subscriber.Connected).Should(BeFalse())
+		for i := 0; i < 3; i++ {
+			_, err := js.Publish("Telegram.Serial", []byte("late"))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		delivered := func() uint64 {
+			consumer, err := js.ConsumerInfo("TELEGRAMS", "caatsm")
+			Expect(err).NotTo(HaveOccurred())
+			return consumer.Delivered.Consumer
+		}
+		Consistently(delivered, 500*time.Millisecond, 50*time.Millisecond).Should(Equal(uint64(1)))
+
+		close(handler.release)
+		Eventually(result).Should(Receive(BeNil()))
+		consumer, err := js.ConsumerInfo("TELEGRAMS", "caatsm")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(consumer.NumRedelivered).To(BeZero())
+		Expect(consumer.NumPending).To(Equal(uint64(3)))
+	})
+
+	It("should give up on the message in flight after the timeout", func() {
+		DeferCleanup(func() { close(handler.release) })
+		err := subscriber.Shutdown(100 * time.Millisecond)
+		Expect(err).To(MatchError(ContainSubstring("1 messages still in flight")))
+		Eventually(done).Should(BeClosed())
+	})
+})
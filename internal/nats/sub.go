@@ -2,67 +2,272 @@ package nats
 
 import (
 	"caatsm/internal/config"
+	"caatsm/internal/domain"
 	"caatsm/internal/iface"
+	"caatsm/internal/metrics"
+	"caatsm/internal/parsers"
+	"caatsm/internal/tracing"
 	"caatsm/pkg/utils"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/ThreeDotsLabs/watermill"
-	"github.com/ThreeDotsLabs/watermill-nats/v2/pkg/nats"
 	"github.com/ThreeDotsLabs/watermill/message"
 	nc "github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type NatsSubscriber struct {
-	config     *config.Config
-	subscriber *nats.Subscriber
+	config    *config.Config
+	conn      *nc.Conn
+	js        nc.JetStreamContext
+	closed    chan struct{}
+	marshaler *PlainTextMarshaler
+	mu        sync.Mutex
+	sub       *nc.Subscription
+	pool      *WorkerPool
+	draining  atomic.Bool
 }
 
 func NewSub(config *config.Config) *NatsSubscriber {
-	logger := watermill.NewStdLogger(false, false)
-	marshaler := &PlainTextMarshaler{}
-	options := []nc.Option{
-		nc.RetryOnFailedConnect(true),
-		nc.Timeout(config.Timeouts.Server),
-		nc.ReconnectWait(config.Timeouts.ReconnectWait),
-	}
-	jsConfig := nats.JetStreamConfig{Disabled: true}
-	subscriber, _ := nats.NewSubscriber(
-		nats.SubscriberConfig{
-			URL:            config.Nats.URL,
-			CloseTimeout:   config.Timeouts.Close,
-			AckWaitTimeout: config.Timeouts.AckWait,
-			NatsOptions:    options,
-			Unmarshaler:    marshaler,
-			JetStream:      jsConfig,
-		},
-		logger,
-	)
+	logger := utils.GetSugaredLogger()
+	closed := make(chan struct{})
+	conn, err := connect(config, nc.ClosedHandler(func(*nc.Conn) { close(closed) }))
+	if err != nil {
+		logger.Errorf("Failed to connect to nats: %v", err)
+		return &NatsSubscriber{config: config, closed: closed, marshaler: &PlainTextMarshaler{}}
+	}
+	js, err := jetStream(conn, config)
+	if err != nil {
+		logger.Errorf("Failed to set up JetStream, falling back to core NATS: %v", err)
+	}
 	return &NatsSubscriber{
-		config:     config,
-		subscriber: subscriber,
+		config:    config,
+		conn:      conn,
+		js:        js,
+		closed:    closed,
+		marshaler: &PlainTextMarshaler{},
 	}
 }
 
+// Subscribe receives messages from the subscription topic and hands them to a
+// pool of workers until the connection is closed. Messages are received in a
+// callback rather than through the watermill subscriber, which waits for each
+// message to be acked before delivering the next one. With JetStream the
+// messages come from a durable consumer and are acked once handled.
+//
+// When a queue group is configured, the instances subscribed with the same group
+// share the messages, each one handled by a single instance. Ordering per
+// originator then only holds within an instance.
 func (n *NatsSubscriber) Subscribe(config *config.Config, handlers iface.MessageHandler) {
 	logger := utils.GetSugaredLogger()
+	if n.conn == nil {
+		logger.Error("Failed to subscribe to topic: no nats connection")
+		return
+	}
+	defer n.conn.Close()
+
+	pool := NewWorkerPool(handlers, config.Subscription.Workers)
+	pool.Start()
+	defer func() {
+		// after Shutdown the pool is already drained, or given up on
+		if !n.draining.Load() {
+			pool.Stop()
+		}
+	}()
+	dispatch := func(raw *nc.Msg) {
+		// a message received while draining is left unacked, so JetStream
+		// redelivers it after the ack wait rather than at once
+		if n.draining.Load() {
+			return
+		}
+		ctx, span := tracing.Start(tracing.ExtractHeader(context.Background(), raw.Header), "nats.receive",
+			attribute.String("messaging.destination.name", raw.Subject))
+		msg, err := n.marshaler.Unmarshal(raw)
+		if err != nil {
+			logger.Errorf("Failed to read message: %v", err)
+			tracing.End(span, err)
+			return
+		}
+		msg.SetContext(ctx)
+		span.SetAttributes(attribute.String("messaging.message.id", msg.UUID))
+		// keeps JetStream from redelivering the message while it waits on its worker
+		var progress func()
+		if n.js != nil {
+			progress = func() { raw.InProgress() }
+		}
+		queued := pool.Dispatch(parsers.Originator(string(msg.Payload)), msg, progress, func(err error) {
+			n.settle(raw, msg, err)
+			tracing.End(span, err)
+		})
+		if !queued {
+			tracing.End(span, nil)
+		}
+	}
 
-	defer n.subscriber.Close()
-	messages, err := n.subscriber.Subscribe(context.Background(), config.Subscription.Topic)
+	topic, group := config.Subscription.Topic, config.Subscription.QueueGroup
+	var (
+		sub *nc.Subscription
+		err error
+	)
+	switch {
+	case n.js != nil:
+		if err = ensureConsumer(n.js, config); err == nil {
+			sub, err = n.js.QueueSubscribe(topic, group, dispatch,
+				nc.Bind(config.JetStream.Stream, config.JetStream.Durable), nc.ManualAck())
+		}
+	case group != "":
+		sub, err = n.conn.QueueSubscribe(topic, group, dispatch)
+	default:
+		sub, err = n.conn.Subscribe(topic, dispatch)
+	}
 	if err != nil {
 		logger.Errorf("Failed to subscribe to topic: %v", err)
 		return
 	}
+	n.mu.Lock()
+	n.sub, n.pool = sub, pool
+	n.mu.Unlock()
+	<-n.closed
+}
+
+// Close closes the connection, which ends Subscribe
+func (n *NatsSubscriber) Close() {
+	if n.conn != nil {
+		n.conn.Close()
+	}
+}
+
+// Connected reports whether the subscriber is connected and taking messages
+func (n *NatsSubscriber) Connected() bool {
+	return n.conn != nil && n.conn.IsConnected() && !n.draining.Load()
+}
+
+// LastHandled returns when the last message was handled
+func (n *NatsSubscriber) LastHandled() time.Time {
+	if pool := n.workerPool(); pool != nil {
+		return pool.LastHandled()
+	}
+	return time.Time{}
+}
+
+// BacklogSince returns when messages last started waiting for the workers
+func (n *NatsSubscriber) BacklogSince() time.Time {
+	if pool := n.workerPool(); pool != nil {
+		return pool.BacklogSince()
+	}
+	return time.Time{}
+}
 
-	for msg := range messages {
-		if err := handlers.HandleMessage(msg.Payload, msg.UUID); err == nil {
-			logger.Infof("Message handled: %s", msg.UUID)
-			msg.Ack()
-		} else {
-			logger.Errorf("Failed to handle message [%s]: %v", msg.UUID, err)
-			msg.Nack()
+// Backlog returns the number of messages queued or being handled
+func (n *NatsSubscriber) Backlog() int {
+	if pool := n.workerPool(); pool != nil {
+		return pool.Pending()
+	}
+	return 0
+}
+
+func (n *NatsSubscriber) workerPool() *WorkerPool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.pool
+}
+
+// Shutdown stops taking new messages, waits at most timeout for the messages
+// being handled to be settled, then closes the connection. It returns an error
+// when some were still being handled; those are redelivered with JetStream.
+//
+// With JetStream the subscription is bound to the durable consumer, so
+// unsubscribing stops the deliveries and keeps the consumer.
+func (n *NatsSubscriber) Shutdown(timeout time.Duration) error {
+	n.draining.Store(true)
+	n.mu.Lock()
+	sub, pool := n.sub, n.pool
+	n.mu.Unlock()
+	if sub != nil {
+		if err := sub.Unsubscribe(); err != nil {
+			utils.GetSugaredLogger().Warnf("Failed to unsubscribe: %v", err)
 		}
 	}
+	var err error
+	if pool != nil && !pool.StopWithin(timeout) {
+		err = fmt.Errorf("%d messages still in flight after %v", pool.Pending(), timeout)
+	}
+	n.Close()
+	return err
+}
+
+// settle acks a handled JetStream message, or naks it for redelivery when
+// handling failed. A rejected message, or one that failed on its last delivery,
+// is sent to the dead-letter topic instead and acked once it is stored there.
+func (n *NatsSubscriber) settle(raw *nc.Msg, msg *message.Message, err error) {
+	logger := utils.GetSugaredLogger()
+	attempts := deliveries(raw)
+	var (
+		rejected *parsers.RejectedError
+		failed   *SinkError
+	)
+	switch {
+	case err == nil:
+	case errors.As(err, &rejected):
+		err = n.deadLetter(domain.NewDeadLetter(msg.UUID, msg.Payload, rejected.Stage, rejected.Reason, attempts))
+	case n.js == nil || n.lastDelivery(attempts):
+		stage := domain.StagePersistence
+		if errors.As(err, &failed) {
+			stage = failed.Stage
+		}
+		err = n.deadLetter(domain.NewDeadLetter(msg.UUID, msg.Payload, stage, err.Error(), attempts))
+	}
+	if err != nil {
+		logger.Errorf("Failed to dead-letter message [%s]: %v", msg.UUID, err)
+	}
+	if n.js == nil {
+		return
+	}
+	if err == nil {
+		err = raw.Ack()
+	} else {
+		err = raw.Nak()
+	}
+	if err != nil && !errors.Is(err, nc.ErrMsgAlreadyAckd) {
+		logger.Warnf("Failed to acknowledge message [%s]: %v", msg.UUID, err)
+	}
+}
+
+// deliveries returns how many times a JetStream message was delivered, 1 for core NATS
+func deliveries(raw *nc.Msg) int {
+	if metadata, err := raw.Metadata(); err == nil {
+		return int(metadata.NumDelivered)
+	}
+	return 1
+}
+
+func (n *NatsSubscriber) lastDelivery(attempts int) bool {
+	return n.config.JetStream.MaxDeliver > 0 && attempts >= n.config.JetStream.MaxDeliver
+}
+
+// deadLetter publishes a rejected telegram on the dead-letter topic
+func (n *NatsSubscriber) deadLetter(letter *domain.DeadLetter) error {
+	data, err := json.Marshal(letter)
+	if err != nil {
+		return err
+	}
+	topic := n.config.Publisher.DeadLetterTopic
+	utils.GetSugaredLogger().Warnf("Dead-lettering message [%s] at %s after %d attempts: %s", letter.Uuid, letter.Stage, letter.Attempts, letter.Reason)
+	if n.js != nil {
+		_, err = n.js.Publish(topic, data)
+	} else {
+		err = n.conn.Publish(topic, data)
+	}
+	if err == nil {
+		metrics.DeadLetters.WithLabelValues(letter.Stage).Inc()
+	}
+	return err
 }
 
 type PlainTextMarshaler struct{}
@@ -0,0 +1,50 @@
+package nats
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"caatsm/pkg/utils"
+	"strings"
+)
+
+// subjectTemplate returns the configured subject template, nil when there is
+// none or it is invalid
+func subjectTemplate(cfg *config.Config) *domain.SubjectTemplate {
+	template, err := cfg.Publisher.Subject()
+	if err != nil {
+		utils.GetSugaredLogger().Errorf("Ignoring the subject template, publishing to %s: %v", cfg.Publisher.Topic, err)
+		return nil
+	}
+	return template
+}
+
+// subject returns the subject of a parsed message, the topic without a template
+func subject(template *domain.SubjectTemplate, topic string, message *domain.ParsedMessage) string {
+	if template == nil {
+		return topic
+	}
+	return template.Render(message)
+}
+
+// metricTopic returns the template for a subject rendered from it, and the
+// topic otherwise, so the publish metrics have one label per template
+func metricTopic(template *domain.SubjectTemplate, topic string) string {
+	if template != nil && subjectMatches(template.Wildcard(), topic) {
+		return template.String()
+	}
+	return topic
+}
+
+// subjectMatches reports whether a subject is matched by a subject with wildcards
+func subjectMatches(pattern, subject string) bool {
+	patterns, tokens := strings.Split(pattern, "."), strings.Split(subject, ".")
+	for i, p := range patterns {
+		if p == ">" {
+			return len(tokens) > i
+		}
+		if i >= len(tokens) || (p != "*" && p != tokens[i]) {
+			return false
+		}
+	}
+	return len(patterns) == len(tokens)
+}
@@ -0,0 +1,103 @@
+package parsers
+
+import (
+	"caatsm/internal/domain"
+	"strings"
+)
+
+// priorityIndicators lists the AFTN priority indicators, most urgent first.
+var priorityIndicators = []string{"SS", "DD", "FF", "GG", "KK"}
+
+// IsValidPriority reports whether the indicator is one of SS/DD/FF/GG/KK.
+func IsValidPriority(indicator string) bool {
+	for _, p := range priorityIndicators {
+		if p == indicator {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSITAPriority reports whether the indicator is a SITA Type-B priority, e.g. QU or QK.
+func IsSITAPriority(indicator string) bool {
+	return sitaPriorityExpression.MatchString(indicator)
+}
+
+// IsSITAAddress reports whether the address is a 7-character SITA address:
+// a 3-letter location, a 2-letter department and a 2-character airline designator.
+func IsSITAAddress(address string) bool {
+	return SITAAddressExpression.MatchString(address)
+}
+
+// IsValidAddress reports whether the address is an 8-letter AFTN address:
+// a 4-letter location indicator, a 3-letter designator and a filler letter.
+func IsValidAddress(address string) bool {
+	return AddressExpression.MatchString(address)
+}
+
+// LocationIndicator returns the ICAO location indicator of an AFTN address,
+// e.g. "ZBTJ" for "ZBTJZPZX", or an empty string when the address is invalid.
+func LocationIndicator(address string) string {
+	if data := extract(address, AddressExpression); data != nil {
+		return data["location"]
+	}
+	return ""
+}
+
+func validatePriority(value string) *domain.HeaderError {
+	if IsValidPriority(value) || IsSITAPriority(value) {
+		return nil
+	}
+	return &domain.HeaderError{
+		Field:  FieldPriority,
+		Value:  value,
+		Reason: "must be one of " + strings.Join(priorityIndicators, "/") + " or a SITA Q priority",
+	}
+}
+
+// validateAddress accepts an AFTN address, or in a SITA header (sita) a SITA address as well.
+func validateAddress(field, value string, sita bool) *domain.HeaderError {
+	if IsValidAddress(value) || sita && IsSITAAddress(value) {
+		return nil
+	}
+	reason := "must be 8 letters: location indicator, designator and filler"
+	if sita {
+		reason = "must be a 7-character SITA or an 8-letter AFTN address"
+	}
+	if FilingTimeExpression.MatchString(value) {
+		reason = "filing time found in address list"
+	}
+	return &domain.HeaderError{Field: field, Value: value, Reason: reason}
+}
+
+func validateFilingTime(value string) *domain.HeaderError {
+	if FilingTimeExpression.MatchString(value) {
+		return nil
+	}
+	return &domain.HeaderError{Field: FieldFilingTime, Value: value, Reason: "must be DDHHMM"}
+}
+
+// parseAddresses splits a line of addresses, keeping the addresses that are
+// not valid, such as SITA addressees of an AFTN header, and reporting them.
+// A filing time or priority indicator is reported and left out of the list.
+func parseAddresses(field, line string, sita bool) ([]string, []domain.HeaderError) {
+	var (
+		addresses []string
+		errs      []domain.HeaderError
+	)
+	for _, word := range strings.Fields(line) {
+		switch {
+		case FilingTimeExpression.MatchString(word):
+			errs = append(errs, domain.HeaderError{Field: field, Value: word, Reason: "filing time found in address list"})
+			continue
+		case IsValidPriority(word) || IsSITAPriority(word):
+			errs = append(errs, domain.HeaderError{Field: field, Value: word, Reason: "priority indicator found in address list"})
+			continue
+		}
+		if err := validateAddress(field, word, sita); err != nil {
+			errs = append(errs, *err)
+		}
+		addresses = append(addresses, word)
+	}
+	return addresses, errs
+}
@@ -0,0 +1,155 @@
+package parsers
+
+import (
+	"caatsm/internal/domain"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AFTN Address", func() {
+
+	Describe("Priority Indicator", func() {
+		It("should accept SS/DD/FF/GG/KK", func() {
+			for _, p := range []string{"SS", "DD", "FF", "GG", "KK"} {
+				Expect(IsValidPriority(p)).To(BeTrue())
+			}
+		})
+
+		It("should reject anything else", func() {
+			Expect(IsValidPriority("QU")).To(BeFalse())
+			Expect(IsValidPriority("G")).To(BeFalse())
+			Expect(IsValidPriority("")).To(BeFalse())
+		})
+
+		It("should recognise a SITA priority", func() {
+			Expect(IsSITAPriority("QU")).To(BeTrue())
+			Expect(IsSITAPriority("QK")).To(BeTrue())
+			Expect(IsSITAPriority("GG")).To(BeFalse())
+		})
+	})
+
+	Describe("Address", func() {
+		It("should accept an 8-letter address", func() {
+			Expect(IsValidAddress("ZBTJZPZX")).To(BeTrue())
+			Expect(LocationIndicator("ZBTJZPZX")).To(Equal("ZBTJ"))
+		})
+
+		It("should reject a SITA address", func() {
+			Expect(IsValidAddress("TSNZPCA")).To(BeFalse())
+			Expect(LocationIndicator("TSNZPCA")).To(BeEmpty())
+		})
+
+		It("should recognise a SITA address", func() {
+			Expect(IsSITAAddress("TSNZPCA")).To(BeTrue())
+			Expect(IsSITAAddress("CANXT3U")).To(BeTrue())
+			Expect(IsSITAAddress("ZBTJZPZX")).To(BeFalse())
+		})
+
+		It("should reject lower case and digits", func() {
+			Expect(IsValidAddress("zbtjzpzx")).To(BeFalse())
+			Expect(IsValidAddress("ZBTJ1PZX")).To(BeFalse())
+		})
+
+		It("should leave out and report a filing time found among the addresses", func() {
+			addresses, errs := parseAddresses(FieldSecondary, "ZBAAZPZX 141604 ZSSSZPZX", false)
+			Expect(addresses).To(Equal([]string{"ZBAAZPZX", "ZSSSZPZX"}))
+			Expect(errs).To(Equal([]domain.HeaderError{{
+				Field:  FieldSecondary,
+				Value:  "141604",
+				Reason: "filing time found in address list",
+			}}))
+		})
+
+		It("should leave out and report a priority indicator found among the addresses", func() {
+			addresses, errs := parseAddresses(FieldSecondary, "QU PEKUDCA TSNUOCA", true)
+			Expect(addresses).To(Equal([]string{"PEKUDCA", "TSNUOCA"}))
+			Expect(errs).To(Equal([]domain.HeaderError{{
+				Field:  FieldSecondary,
+				Value:  "QU",
+				Reason: "priority indicator found in address list",
+			}}))
+		})
+
+		It("should keep a SITA addressee among the addresses", func() {
+			addresses, errs := parseAddresses(FieldSecondary, "ZBAAZPZX PEKUDCA", false)
+			Expect(addresses).To(Equal([]string{"ZBAAZPZX", "PEKUDCA"}))
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Value).To(Equal("PEKUDCA"))
+		})
+	})
+
+	Describe("Header", func() {
+		It("should split secondary addresses into a list", func() {
+			message := `ZCZC TMQ2526 141605
+FF ZBTJZPZX ZBAAZPZX
+ZSSSZPZX ZGGGZPZX
+141604 ZBACZQZX
+(ARR-JAE7433/A0132-RKSI-ZBTJ1604)
+NNNN`
+			parsedHeader, err := ParseHeader(message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedHeader.PriorityIndicator).To(Equal("FF"))
+			Expect(parsedHeader.PrimaryAddress).To(Equal("ZBTJZPZX"))
+			Expect(parsedHeader.SecondaryAddresses).To(Equal([]string{"ZBAAZPZX", "ZSSSZPZX", "ZGGGZPZX"}))
+			Expect(parsedHeader.Originator).To(Equal("ZBACZQZX"))
+			Expect(parsedHeader.OriginatorDateTime).To(Equal("141604"))
+			Expect(parsedHeader.HeaderValid()).To(BeTrue())
+		})
+
+		It("should only warn of an invalid secondary address", func() {
+			message := `ZCZC TMQ2526 141605
+FF ZBTJZPZX PEKUDCA
+141604 ZBACZQZX
+(ARR-JAE7433/A0132-RKSI-ZBTJ1604)
+NNNN`
+			parsedHeader, err := ParseHeader(message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedHeader.HeaderValid()).To(BeTrue())
+			Expect(parsedHeader.HeaderWarnings).To(HaveLen(1))
+			Expect(parsedHeader.HeaderWarnings[0].Field).To(Equal(FieldSecondary))
+		})
+
+		It("should accept a SITA header", func() {
+			message := `ZCZC TMQ2611 151524
+QU TSNZPCA PEKUDCA
+.HAKUOHU 151234
+(ARR-CHH7670-ZBTJ-ZJHK0323)
+NNNN`
+			parsedHeader, err := ParseHeader(message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedHeader.PriorityIndicator).To(Equal("QU"))
+			Expect(parsedHeader.Originator).To(Equal("HAKUOHU"))
+			Expect(parsedHeader.HeaderErrors).To(BeEmpty())
+			Expect(parsedHeader.HeaderWarnings).To(BeEmpty())
+		})
+
+		It("should only warn of a filing time among the secondary addresses", func() {
+			message := `ZCZC TMQ2526 141605
+FF ZBTJZPZX
+ZBAAZPZX 141604 ZSSSZPZX
+141604 ZBACZQZX
+(ARR-JAE7433/A0132-RKSI-ZBTJ1604)
+NNNN`
+			parsedHeader, err := ParseHeader(message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedHeader.SecondaryAddresses).To(Equal([]string{"ZBAAZPZX", "ZSSSZPZX"}))
+			Expect(parsedHeader.HeaderValid()).To(BeTrue())
+			Expect(parsedHeader.HeaderWarnings).To(HaveLen(1))
+			Expect(parsedHeader.HeaderWarnings[0].Value).To(Equal("141604"))
+		})
+
+		It("should report a missing originator", func() {
+			message := `ZCZC TMQ2526 141605
+FF ZBTJZPZX
+ZSSSZPZX
+(ARR-JAE7433/A0132-RKSI-ZBTJ1604)
+NNNN`
+			parsedHeader, err := ParseHeader(message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedHeader.SecondaryAddresses).To(Equal([]string{"ZSSSZPZX"}))
+			Expect(parsedHeader.HeaderErrors).To(HaveLen(1))
+			Expect(parsedHeader.HeaderErrors[0].Field).To(Equal(FieldOriginator))
+		})
+	})
+})
@@ -1,23 +1,26 @@
 package parsers
 
 import (
+	"caatsm/internal/airports"
 	"caatsm/internal/domain"
+	"caatsm/internal/metrics"
 	"caatsm/pkg/utils"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
-
-	"github.com/google/uuid"
 )
 
 const (
 	SSR             = "ssr"
 	DepartureCode   = "dep"
 	DepartureTime   = "dep_time"
+	DepartureDate   = "dep_date"
 	ArrivalCode     = "arr"
 	ArrivalTime     = "arr_time"
+	ArrivalDate     = "arr_date"
 	DestinationCode = "dest"
 	OtherInfo       = "other"
 
@@ -89,8 +92,9 @@ func (parser *BodyParser) Parse() (string, interface{}, error) {
 	}
 
 	if patternConfig, exists := parser.bodyPatterns[category]; exists && patternConfig.Patterns != nil {
-		for _, p := range patternConfig.Patterns {
+		for i, p := range patternConfig.Patterns {
 			if data := extract(parser.body, p.Expression); data != nil {
+				metrics.PatternHits.WithLabelValues(category, strconv.Itoa(i)).Inc()
 				return parser.createBodyData(data)
 			}
 		}
@@ -199,13 +203,16 @@ func Parse(rawText string) *domain.ParsedMessage {
 	if err != nil {
 		msg := domain.NewParsedMessage()
 		msg.Content = rawText
+		msg.Uuid = Identity(msg)
 		return msg
 	}
 
 	bodyParser := NewBodyParser(message.Body)
 	category, bodyData, err := bodyParser.Parse()
+	message.SchemaVersion = domain.SchemaVersion
 	message.Category = category
 	message.ParsedAt = time.Now()
+	message.Uuid = Identity(&message)
 
 	if err != nil {
 		message.Comments = err.Error()
@@ -213,7 +220,7 @@ func Parse(rawText string) *domain.ParsedMessage {
 	}
 	message.Parsed = true
 	message.BodyData = bodyData
-	message.Uuid = uuid.New().String()
+	message.Airports = airports.Lookup(domain.AirportCodes(bodyData))
 	return &message
 }
 
@@ -245,23 +252,46 @@ func ParseHeader(fullMessage string) (domain.ParsedMessage, error) {
 		return domain.ParsedMessage{Content: fullMessage}, err
 	}
 
-	priorityIndicator, primaryAddress := parsePriorityAndPrimary(lines[1])
-	secondaryAddresses, originator, originatorDateTime, body := parseRemainingLines(lines[2:])
+	priorityIndicator, primaryAddress, addresses, headerErrors := parsePriorityAndPrimary(lines[1])
+	secondaryAddresses, originator, originatorDateTime, body, errs := parseRemainingLines(lines[2:], IsSITAPriority(priorityIndicator))
+	headerErrors, headerWarnings := splitHeaderErrors(append(headerErrors, errs...))
+	if len(headerErrors) > 0 {
+		log.Warnf("invalid header fields in %s: %v", messageID, headerErrors)
+	}
+	if len(headerWarnings) > 0 {
+		log.Infof("header warnings in %s: %v", messageID, headerWarnings)
+	}
 
 	return domain.ParsedMessage{
 		MessageID:          messageID,
 		DateTime:           dateTime,
 		PriorityIndicator:  priorityIndicator,
 		PrimaryAddress:     primaryAddress,
-		SecondaryAddresses: secondaryAddresses,
+		SecondaryAddresses: append(addresses, secondaryAddresses...),
 		Originator:         originator,
 		OriginatorDateTime: originatorDateTime,
 		Content:            fullMessage,
 		Body:               body,
 		ReceivedAt:         time.Now(),
+		HeaderErrors:       headerErrors,
+		HeaderWarnings:     headerWarnings,
 	}, nil
 }
 
+// splitHeaderErrors keeps the errors of the priority, primary address and
+// originator, which reject a message, and returns the others as warnings
+func splitHeaderErrors(all []domain.HeaderError) (errs, warnings []domain.HeaderError) {
+	for _, headerError := range all {
+		switch headerError.Field {
+		case FieldPriority, FieldPrimary, FieldOriginator:
+			errs = append(errs, headerError)
+		default:
+			warnings = append(warnings, headerError)
+		}
+	}
+	return errs, warnings
+}
+
 func parseStartIndicator(line string) (string, string, string, error) {
 	parts := strings.Fields(line)
 	if len(parts) >= 3 && strings.HasPrefix(parts[0], StartIndicatorPrefix) {
@@ -271,22 +301,40 @@ func parseStartIndicator(line string) (string, string, string, error) {
 	return "", "", "", fmt.Errorf("invalid start indicator line format: %s", line)
 }
 
-func parsePriorityAndPrimary(line string) (string, string) {
+// parsePriorityAndPrimary splits the priority line into the priority indicator,
+// the primary address and any further addresses on the same line. A SITA
+// priority such as QU makes SITA addresses valid as well.
+func parsePriorityAndPrimary(line string) (string, string, []string, []domain.HeaderError) {
 	parts := strings.Fields(line)
-	if len(parts) >= 2 {
-		return parts[0], parts[1]
+	if len(parts) < 2 {
+		utils.GetSugaredLogger().Warnf("invalid priority and primary address line format: %s", line)
+		return "", "", nil, []domain.HeaderError{{
+			Field:  FieldPrimary,
+			Value:  line,
+			Reason: "priority indicator and primary address are required",
+		}}
 	}
-	utils.GetSugaredLogger().Warnf("invalid priority and primary address line format: %s", line)
-	return "", ""
+
+	var errs []domain.HeaderError
+	sita := IsSITAPriority(parts[0])
+	if err := validatePriority(parts[0]); err != nil {
+		errs = append(errs, *err)
+	}
+	if err := validateAddress(FieldPrimary, parts[1], sita); err != nil {
+		errs = append(errs, *err)
+	}
+	addresses, addressErrs := parseAddresses(FieldSecondary, strings.Join(parts[2:], " "), sita)
+	return parts[0], parts[1], addresses, append(errs, addressErrs...)
 }
 
-func parseRemainingLines(lines []string) (string, string, string, string) {
+func parseRemainingLines(lines []string, sita bool) ([]string, string, string, string, []domain.HeaderError) {
 	var (
-		secondaryAddresses string
+		secondaryAddresses []string
 		originator         string
 		originatorDateTime string
 		bodyAndFooter      strings.Builder
 		headerEnded        bool
+		errs               []domain.HeaderError
 	)
 
 	for _, line := range lines {
@@ -314,13 +362,26 @@ func parseRemainingLines(lines []string) (string, string, string, string) {
 					originatorDateTime = o1
 					originator = o2
 				} else {
-					secondaryAddresses = secondaryAddresses + " " + line
+					addresses, addressErrs := parseAddresses(FieldSecondary, line, sita)
+					secondaryAddresses = append(secondaryAddresses, addresses...)
+					errs = append(errs, addressErrs...)
 				}
 			}
 		}
 	}
 
-	return secondaryAddresses, originator, originatorDateTime, bodyAndFooter.String()
+	if originator == "" {
+		errs = append(errs, domain.HeaderError{Field: FieldOriginator, Reason: "originator line not found"})
+	} else {
+		if err := validateAddress(FieldOriginator, originator, sita); err != nil {
+			errs = append(errs, *err)
+		}
+		if err := validateFilingTime(originatorDateTime); err != nil {
+			errs = append(errs, *err)
+		}
+	}
+
+	return secondaryAddresses, originator, originatorDateTime, bodyAndFooter.String(), errs
 }
 
 func getOriginator(line string) (string, string) {
@@ -332,6 +393,25 @@ func getOriginator(line string) (string, string) {
 	return "", ""
 }
 
+// Originator returns the originator address of a raw telegram without parsing the
+// body, or an empty string when the header has no originator line.
+func Originator(rawText string) string {
+	lines := strings.Split(cleanMessage(rawText), "\n")
+	for i := 2; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(line, EndHeaderMarker) {
+			if fields := strings.Fields(line[1:]); len(fields) > 0 {
+				return fields[0]
+			}
+			return ""
+		}
+		if match := originator.FindStringSubmatch(line); len(match) >= 3 {
+			return match[2]
+		}
+	}
+	return ""
+}
+
 func parseOther(text string) map[string]string {
 	data := make(map[string]string)
 	for _, re := range otherPatterns {
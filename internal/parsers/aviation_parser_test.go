@@ -2,9 +2,11 @@ package parsers
 
 import (
 	"caatsm/internal/domain"
+	"caatsm/internal/metrics"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
 var _ = Describe("Aviation Parser", func() {
@@ -51,7 +53,8 @@ NNNN`
 			Expect(parsedHeader.DateTime).To(Equal("160530"))
 			Expect(parsedHeader.PriorityIndicator).To(Equal("QU"))
 			Expect(parsedHeader.PrimaryAddress).To(Equal("TSNZPCA"))
-			Expect(parsedHeader.SecondaryAddresses).To(Equal(" QU PEKUDCA TSNUOCA TSNZPCA TSNUFCA"))
+			Expect(parsedHeader.SecondaryAddresses).To(Equal([]string{"PEKUDCA", "TSNUOCA", "TSNZPCA", "TSNUFCA"}))
+			Expect(parsedHeader.HeaderValid()).To(BeFalse())
 		})
 
 		It("should parse the header correctly with originator information", func() {
@@ -92,9 +95,20 @@ NNNN`
 			Expect(parsedHeader.DateTime).To(Equal("171000"))
 			Expect(parsedHeader.PriorityIndicator).To(Equal("QU"))
 			Expect(parsedHeader.PrimaryAddress).To(Equal("TSNZPCA"))
-			Expect(parsedHeader.SecondaryAddresses).To(Equal(" QU PEKUDCA TSNUOCA TSNZPCA TSNUFCA"))
+			Expect(parsedHeader.SecondaryAddresses).To(Equal([]string{"PEKUDCA", "TSNUOCA", "TSNZPCA", "TSNUFCA"}))
 			Expect(parsedHeader.Originator).To(Equal("SELOZKE"))
 			Expect(parsedHeader.OriginatorDateTime).To(Equal("170999"))
+			Expect(parsedHeader.HeaderValid()).To(BeTrue())
+			Expect(parsedHeader.HeaderWarnings).To(ContainElement(domain.HeaderError{
+				Field:  FieldSecondary,
+				Value:  "QU",
+				Reason: "priority indicator found in address list",
+			}))
+			Expect(parsedHeader.HeaderWarnings).To(ContainElement(domain.HeaderError{
+				Field:  FieldFilingTime,
+				Value:  "170999",
+				Reason: "must be DDHHMM",
+			}))
 		})
 
 	})
@@ -137,6 +151,12 @@ NNNN`
 				Expect(arrMessage.ArrivalTime).To(Equal("1614"))
 			})
 
+			It("should count the pattern that matched", func() {
+				hits := testutil.ToFloat64(metrics.PatternHits.WithLabelValues("ARR", "0"))
+				_, _, err := NewBodyParser(body).Parse()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(testutil.ToFloat64(metrics.PatternHits.WithLabelValues("ARR", "0"))).To(Equal(hits + 1))
+			})
 		})
 
 		Context("with ARR body", func() {
@@ -288,7 +308,8 @@ NNNN
 				Expect(parsedMessage.MessageID).To(Equal("TMQ2526"))
 				Expect(parsedMessage.DateTime).To(Equal("141605"))
 				Expect(parsedMessage.PrimaryAddress).To(Equal("ZBTJZPZX"))
-				Expect(parsedMessage.SecondaryAddresses).To(Equal(""))
+				Expect(parsedMessage.SecondaryAddresses).To(BeEmpty())
+				Expect(parsedMessage.HeaderErrors).To(BeEmpty())
 				Expect(parsedMessage.PriorityIndicator).To(Equal("FF"))
 				Expect(parsedMessage.OriginatorDateTime).To(Equal("141604"))
 				Expect(parsedMessage.Originator).To(Equal("ZBACZQZX"))
@@ -300,6 +321,10 @@ NNNN
 				Expect(arrmsg.DepartureAirport).To(Equal("RKSI"))
 				Expect(arrmsg.ArrivalAirport).To(Equal("ZBTJ"))
 				Expect(arrmsg.ArrivalTime).To(Equal("1604"))
+
+				Expect(parsedMessage.Airports).To(HaveLen(2))
+				Expect(parsedMessage.Airports[0].IATA).To(Equal("ICN"))
+				Expect(parsedMessage.Airports[1].IATA).To(Equal("TSN"))
 			})
 		})
 
@@ -339,7 +364,8 @@ NNNN
 				Expect(parsedMessage.MessageID).To(Equal("TMQ2617"))
 				Expect(parsedMessage.DateTime).To(Equal("142150"))
 				Expect(parsedMessage.PrimaryAddress).To(Equal("ZBTJZPZX"))
-				Expect(parsedMessage.SecondaryAddresses).To(Equal(""))
+				Expect(parsedMessage.SecondaryAddresses).To(BeEmpty())
+				Expect(parsedMessage.HeaderErrors).To(BeEmpty())
 				Expect(parsedMessage.PriorityIndicator).To(Equal("GG"))
 				Expect(parsedMessage.OriginatorDateTime).To(Equal("150551"))
 				Expect(parsedMessage.Originator).To(Equal("ZBTJUOBK"))
@@ -393,26 +419,39 @@ NNNN`
 		})
 
 		It("should parse priority and primary address correctly", func() {
-			line := "QU TSNZPCA"
-			priority, primary := parsePriorityAndPrimary(line)
-			Expect(priority).To(Equal("QU"))
-			Expect(primary).To(Equal("TSNZPCA"))
+			line := "FF ZBTJZPZX ZBAAZPZX"
+			priority, primary, addresses, errs := parsePriorityAndPrimary(line)
+			Expect(priority).To(Equal("FF"))
+			Expect(primary).To(Equal("ZBTJZPZX"))
+			Expect(addresses).To(Equal([]string{"ZBAAZPZX"}))
+			Expect(errs).To(BeEmpty())
 		})
 
-		It("should return empty strings for invalid priority and primary address line", func() {
+		It("should report an invalid priority and primary address line", func() {
 			line := "Invalid-Line"
-			priority, primary := parsePriorityAndPrimary(line)
+			priority, primary, addresses, errs := parsePriorityAndPrimary(line)
 			Expect(priority).To(BeEmpty())
 			Expect(primary).To(BeEmpty())
+			Expect(addresses).To(BeEmpty())
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal(FieldPrimary))
 		})
 
 		It("should parse remaining lines correctly", func() {
 			lines := []string{"QU PEKUDCA TSNUOCA TSNZPCA TSNUFCA", ".SELOZKE 170999", "BEGIN PART 01"}
-			secondaryAddresses, originator, originatorDateTime, bodyAndFooter := parseRemainingLines(lines)
-			Expect(secondaryAddresses).To(Equal(" QU PEKUDCA TSNUOCA TSNZPCA TSNUFCA"))
+			secondaryAddresses, originator, originatorDateTime, bodyAndFooter, errs := parseRemainingLines(lines, true)
+			Expect(secondaryAddresses).To(Equal([]string{"PEKUDCA", "TSNUOCA", "TSNZPCA", "TSNUFCA"}))
 			Expect(originator).To(Equal("SELOZKE"))
 			Expect(originatorDateTime).To(Equal("170999"))
 			Expect(bodyAndFooter).To(Equal("BEGIN PART 01\n"))
+			Expect(errs).To(HaveLen(2))
+		})
+
+		It("should find the originator without parsing the body", func() {
+			message := "ZCZC TMQ2611 151524\nGG ZBTJZPZX\n151524 ZBBBZGZX\n(ARR-CCA1532-ZSSS0730-ZBTJ0940)\nNNNN"
+			Expect(Originator(message)).To(Equal("ZBBBZGZX"))
+			Expect(Originator("ZCZC TMQ2611 151524\nQU PEKUDCA\n.SELOZKE 170999\nBEGIN PART 01\nNNNN")).To(Equal("SELOZKE"))
+			Expect(Originator("not a telegram")).To(BeEmpty())
 		})
 	})
 })
@@ -14,14 +14,23 @@ const (
 	CategoryCancellation = "CNL"
 	CategoryDelay        = "DLA"
 	CategoryFlightPlan   = "FPL"
+	CategorySchedule     = "SCHEDULE"
 
-	CANCELLED    = "CNL"
-	AirportCode  = "airport"
-	Date         = "date"
-	Task         = "task"
-	Index        = "idx"
-	FlightNumber = "number"
-	Register     = "reg"
+	CANCELLED = "CNL"
+	// CancelledComment marks a schedule line of a cancelled flight
+	CancelledComment = "Cancelled"
+	AirportCode      = "airport"
+	Date             = "date"
+	Task             = "task"
+	Index            = "idx"
+	FlightNumber     = "number"
+	Register         = "reg"
+
+	FieldPriority   = "priorityIndicator"
+	FieldPrimary    = "primaryAddress"
+	FieldSecondary  = "secondaryAddresses"
+	FieldOriginator = "originator"
+	FieldFilingTime = "originatorDateTime"
 )
 
 // Regular expression patterns
@@ -30,9 +39,12 @@ const (
 	IndexPattern        = `^(?P<idx>\(?L?[0-9]+\)?:?\.?)$`
 	DatePattern         = `^(?P<date>\d{2}\w{3})$`
 	TaskPattern         = `(?P<task>[A-Z]\/[A-Z])$`
-	WaypointPattern     = `^(SI:)?(?P<arr_time>\d{4}(\(\d{2}[A-Z]{3}\))?)?\/?(?P<airport>[A-Z]{3})\/?(?P<dep_time>\d{4}(\(\d{2}[A-Z]{3}\))?)?$`
+	WaypointPattern     = `^(SI:)?((?P<arr_time>\d{4})(\((?P<arr_date>\d{2}[A-Z]{3})\))?)?\/?(?P<airport>[A-Z]{3})\/?((?P<dep_time>\d{4})(\((?P<dep_date>\d{2}[A-Z]{3})\))?)?$`
 	FlightNumberPattern = `^(?P<number>[0-9A-Z][0-9A-Z]\d{3,5}(\/\d+)*)$`
 	RegisterPattern     = `^(?P<reg>B\d{4})$`
+	AddressPattern      = `^(?P<location>[A-Z]{4})(?P<designator>[A-Z]{3})(?P<filler>[A-Z])$`
+	SITAAddressPattern  = `^(?P<location>[A-Z]{3})(?P<department>[A-Z]{2})(?P<airline>[A-Z0-9]{2})$`
+	FilingTimePattern   = `^(?P<day>0[1-9]|[12]\d|3[01])(?P<hour>[01]\d|2[0-3])(?P<minute>[0-5]\d)$`
 
 	ArrPatternString = `^\((?P<category>[A-Z]{3})-(?P<number>[A-Z0-9]+)(\/?(?P<ssr>[A-Z0-9]+))?-(?P<dep>[A-Z]{4})-(?P<arr>[A-Z]{4})(?P<arr_time>\d{4})\)$`
 	DepPatternString = `^\((?P<category>[A-Z]{3})-(?P<number>[A-Z0-9]+)(\/(?P<ssr>[A-Z0-9]+))?-(?P<dep>[A-Z]{4})(?P<dep_time>\d{4})-(?P<arr>[A-Z]{4})\)$`
@@ -50,6 +62,9 @@ var (
 	WaypointExpression     = regexp.MustCompile(WaypointPattern)
 	FlightNumberExpression = regexp.MustCompile(FlightNumberPattern)
 	RegisterExpression     = regexp.MustCompile(RegisterPattern)
+	AddressExpression      = regexp.MustCompile(AddressPattern)
+	SITAAddressExpression  = regexp.MustCompile(SITAAddressPattern)
+	FilingTimeExpression   = regexp.MustCompile(FilingTimePattern)
 	ArrPatternExpression   = regexp.MustCompile(ArrPatternString)
 	DepPatternExpression   = regexp.MustCompile(DepPatternString)
 	FplPatternExpression   = regexp.MustCompile(FplPatternString)
@@ -57,10 +72,15 @@ var (
 	DlaPatternExpression   = regexp.MustCompile(DlaPatternString)
 	BodyTypePattern        = regexp.MustCompile(`^\(([A-Z]{3})(.*\n?)+\)$`)
 
+	separatorExpression       = regexp.MustCompile(`^[-=*_.~#\s]+$`)
+	documentDateExpression    = regexp.MustCompile(`\b(\d{2}(JAN|FEB|MAR|APR|MAY|JUN|JUL|AUG|SEP|OCT|NOV|DEC))\b`)
+	envelopeAddressExpression = regexp.MustCompile(`^[A-Z]{7,8}$`)
+	sitaPriorityExpression    = regexp.MustCompile(`^Q[A-Z]$`)
+
 	categoryRegex      = regexp.MustCompile(`\((?P<category>[A-Z]+)-`)
 	emptyLineRemove    = regexp.MustCompile(`(?m)^\s*$`)
 	bodyOnly           = regexp.MustCompile(`(.|\n)?(ZCZC(.|\n)*)NNNN(.|\n)?$`)
-	originator         = regexp.MustCompile(`(?P<originatorDateTime>[0-9]+)\s(?P<originator>[A-Z]+)`)
+	originator         = regexp.MustCompile(`^(?P<originatorDateTime>[0-9]+)\s+(?P<originator>[A-Z]+)`)
 	navPattern         = regexp.MustCompile(`(?m)NAV\/(?P<nav>\w+)`)
 	remarkPattern      = regexp.MustCompile(`(?s)RMK\/(?P<remark>.*)`)
 	selPattern         = regexp.MustCompile(`(?m)SEL\/(?P<sel>\w+)`)
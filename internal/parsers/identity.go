@@ -0,0 +1,29 @@
+package parsers
+
+import (
+	"caatsm/internal/domain"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// identityNamespace scopes the name-based uuids of telegrams
+var identityNamespace = uuid.MustParse("7c3ca3ac-ae59-4393-9e91-ae66e2e7c323")
+
+// Identity returns a uuid derived from the transmission id, filing time,
+// originator and body of a telegram, so a retransmission or redelivery of the
+// same telegram gets the same uuid. Whitespace and letter case in the body are
+// normalized. A telegram without a header is identified by its whole text.
+func Identity(message *domain.ParsedMessage) string {
+	body := message.Body
+	if body == "" {
+		body = message.Content
+	}
+	key := strings.Join([]string{
+		message.MessageID,
+		message.DateTime,
+		message.Originator,
+		strings.ToUpper(strings.Join(strings.Fields(body), " ")),
+	}, "|")
+	return uuid.NewSHA1(identityNamespace, []byte(key)).String()
+}
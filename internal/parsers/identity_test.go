@@ -0,0 +1,47 @@
+package parsers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Identity", func() {
+	const telegram = `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+
+	It("should give the same telegram the same uuid", func() {
+		Expect(Parse(telegram).Uuid).To(Equal(Parse(telegram).Uuid))
+	})
+
+	It("should ignore whitespace and case in the body", func() {
+		retransmitted := `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)   
+NNNN`
+		Expect(Parse(retransmitted).Uuid).To(Equal(Parse(telegram).Uuid))
+	})
+
+	It("should tell apart telegrams with another body or transmission id", func() {
+		otherBody := `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5471-ZBTJ-ZSHC1614)
+NNNN`
+		otherTransmission := `ZCZC TMQ2531 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+		Expect(Parse(otherBody).Uuid).NotTo(Equal(Parse(telegram).Uuid))
+		Expect(Parse(otherTransmission).Uuid).NotTo(Equal(Parse(telegram).Uuid))
+	})
+
+	It("should identify a telegram without a header by its text", func() {
+		Expect(Parse("NOT A TELEGRAM").Uuid).To(Equal(Parse("not  a telegram").Uuid))
+		Expect(Parse("NOT A TELEGRAM").Uuid).NotTo(BeEmpty())
+	})
+})
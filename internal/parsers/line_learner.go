@@ -0,0 +1,226 @@
+package parsers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// learnStrategy is the field order used to locate header fields in sample lines.
+// The index is only looked for in the first word, as in ParseLine.
+var learnStrategy = []string{Task, Date, FlightNumber, Register}
+
+// LearnReport summarises how well a proposed definition fits the sample lines.
+type LearnReport struct {
+	Lines     int            // sample lines considered
+	Matched   int            // lines parsed with a flight number and waypoints
+	FieldHits map[string]int // lines where each field was found at its proposed position
+	Unmatched []string       // lines the proposed definition could not parse
+}
+
+// MatchRate returns the share of sample lines parsed by the proposed definition.
+func (r *LearnReport) MatchRate() float64 {
+	if r.Lines == 0 {
+		return 0
+	}
+	return float64(r.Matched) / float64(r.Lines)
+}
+
+func (r *LearnReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "matched %d of %d lines (%.1f%%)\n", r.Matched, r.Lines, r.MatchRate()*100)
+	fields := make([]string, 0, len(r.FieldHits))
+	for field := range r.FieldHits {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		fmt.Fprintf(&b, "  %-6s found in %d lines\n", field, r.FieldHits[field])
+	}
+	for _, line := range r.Unmatched {
+		fmt.Fprintf(&b, "  unmatched: %s\n", line)
+	}
+	return b.String()
+}
+
+// sampleLayout is the layout observed in a single sample line.
+type sampleLayout struct {
+	words         int
+	positions     map[string]int
+	waypointStart int
+}
+
+// LearnLineParser infers a LineParser from sample schedule lines of one airline.
+// Each field is placed at the position where it is most often found, provided it
+// is found in at least half of the lines. When airline is empty it is taken from
+// the flight numbers.
+func LearnLineParser(airline string, lines []string) (*LineParser, *LearnReport, error) {
+	var samples []string
+	var layouts []sampleLayout
+	airlineVotes := make(map[string]int)
+	for _, line := range lines {
+		cleanLine := standardizeSpaces(strings.TrimSpace(line))
+		if cleanLine == "" || strings.Contains(cleanLine, CANCELLED) {
+			continue
+		}
+		layout, number := observeLayout(strings.Split(cleanLine, " "))
+		if layout.waypointStart < 0 {
+			continue
+		}
+		if len(number) >= 2 {
+			airlineVotes[number[:2]]++
+		}
+		samples = append(samples, line)
+		layouts = append(layouts, layout)
+	}
+	if len(layouts) == 0 {
+		return nil, nil, fmt.Errorf("no schedule lines with waypoints found in %d lines", len(lines))
+	}
+	if airline == "" {
+		airline = mostCommon(airlineVotes)
+	}
+
+	def := &LineParser{
+		Airlines: []string{airline},
+		Fields:   make(map[int]string),
+	}
+	startVotes := make(map[int]int)
+	fieldVotes := make(map[string]map[int]int)
+	for _, layout := range layouts {
+		startVotes[layout.waypointStart]++
+		for field, position := range layout.positions {
+			if fieldVotes[field] == nil {
+				fieldVotes[field] = make(map[int]int)
+			}
+			fieldVotes[field][position]++
+		}
+	}
+	def.WaypointStart = mostCommonPosition(startVotes)
+	for _, field := range append([]string{Index}, learnStrategy...) {
+		votes := fieldVotes[field]
+		if votes == nil {
+			continue
+		}
+		position := mostCommonPosition(votes)
+		if votes[position]*2 >= len(layouts) && position < def.WaypointStart {
+			if _, taken := def.Fields[position]; !taken {
+				def.Fields[position] = field
+			}
+		}
+	}
+	minWords := 0
+	for _, layout := range layouts {
+		if layout.waypointStart == def.WaypointStart && (minWords == 0 || layout.words < minWords) {
+			minWords = layout.words
+		}
+	}
+	def.MinLen = max(minWords, def.WaypointStart+1)
+
+	if err := ValidateLineParsers([]LineParser{*def}); err != nil {
+		return def, nil, err
+	}
+	return def, evaluate(def, samples), nil
+}
+
+// observeLayout locates the header fields and the first waypoint of a line.
+func observeLayout(words []string) (sampleLayout, string) {
+	layout := sampleLayout{words: len(words), positions: make(map[string]int), waypointStart: -1}
+	offset := 0
+	if extract(words[0], IndexExpression) != nil {
+		layout.positions[Index] = 0
+		offset = 1
+	}
+	last := offset - 1
+	for field, position := range locateFields(words[offset:], learnStrategy) {
+		layout.positions[field] = position + offset
+		if position+offset > last {
+			last = position + offset
+		}
+	}
+	var number string
+	if position, found := layout.positions[FlightNumber]; found {
+		number = words[position]
+	}
+	for i := last + 1; i < len(words); i++ {
+		if isWaypointRun(words[i:]) {
+			layout.waypointStart = i
+			break
+		}
+	}
+	return layout, number
+}
+
+// isWaypointRun reports whether the words are all waypoints or bare times,
+// starting with a waypoint.
+func isWaypointRun(words []string) bool {
+	if extract(words[0], WaypointExpression) == nil {
+		return false
+	}
+	for _, word := range words[1:] {
+		if extract(word, WaypointExpression) == nil && extract(word, AllDigitsExpression) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// evaluate parses the samples with the proposed definition.
+func evaluate(def *LineParser, samples []string) *LearnReport {
+	report := &LearnReport{Lines: len(samples), FieldHits: make(map[string]int)}
+	for _, line := range samples {
+		words := strings.Split(standardizeSpaces(strings.TrimSpace(line)), " ")
+		for position, field := range def.Fields {
+			if position < len(words) && extract(words[position], parserMap[field]) != nil {
+				report.FieldHits[field]++
+			}
+		}
+		schedule := ParseWithDef(line, def)
+		if len(schedule.FlightNumber) > 0 && len(schedule.Waypoints) > 0 && schedule.Comments == "" {
+			report.Matched++
+		} else {
+			report.Unmatched = append(report.Unmatched, line)
+		}
+	}
+	return report
+}
+
+// FormatLineParser renders a definition in the schedules.toml format.
+func FormatLineParser(def *LineParser) string {
+	positions := make([]int, 0, len(def.Fields))
+	for position := range def.Fields {
+		positions = append(positions, position)
+	}
+	sort.Ints(positions)
+	fields := make([]string, 0, len(positions))
+	for _, position := range positions {
+		fields = append(fields, fmt.Sprintf("%d = %q", position, def.Fields[position]))
+	}
+	airlines := make([]string, 0, len(def.Airlines))
+	for _, airline := range def.Airlines {
+		airlines = append(airlines, fmt.Sprintf("%q", airline))
+	}
+	return fmt.Sprintf("[[schedules]]\nairlines = [%s]\nmin_len = %d\nwaypoint_start = %d\nfields = { %s }\n",
+		strings.Join(airlines, ", "), def.MinLen, def.WaypointStart, strings.Join(fields, ", "))
+}
+
+// mostCommon returns the key with the most votes, the smallest key on a tie.
+func mostCommon(votes map[string]int) string {
+	var best string
+	for key, count := range votes {
+		if best == "" || count > votes[best] || (count == votes[best] && key < best) {
+			best = key
+		}
+	}
+	return best
+}
+
+// mostCommonPosition returns the position with the most votes, the smallest on a tie.
+func mostCommonPosition(votes map[int]int) int {
+	best := -1
+	for position, count := range votes {
+		if best < 0 || count > votes[best] || (count == votes[best] && position < best) {
+			best = position
+		}
+	}
+	return best
+}
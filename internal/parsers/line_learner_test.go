@@ -0,0 +1,68 @@
+package parsers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Line Learner", func() {
+
+	Context("8X style lines", func() {
+		lines := []string{
+			"L1:  29OCT  BK2735 B2863  ILS  IS (3/6)  TSN2350(28OCT)   HAK",
+			"L2:  29OCT  BK2736 B2863  ILS  IS (3/6)  HAK0500   TSN",
+			"",
+			"L3:  30OCT  BK2801 B6578  ILS  IS (4/8)  TSN0800   SYX/1200 HAK",
+			"L4:  30OCT  BK2802 CNL",
+		}
+
+		It("should infer the field positions", func() {
+			def, report, err := LearnLineParser("", lines)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(def.Airlines).To(Equal([]string{"BK"}))
+			Expect(def.WaypointStart).To(Equal(7))
+			Expect(def.MinLen).To(Equal(9))
+			Expect(def.Fields).To(Equal(map[int]string{0: Index, 1: Date, 2: FlightNumber, 3: Register}))
+
+			Expect(report.Lines).To(Equal(3))
+			Expect(report.Matched).To(Equal(3))
+			Expect(report.MatchRate()).To(Equal(1.0))
+			Expect(report.FieldHits[FlightNumber]).To(Equal(3))
+		})
+
+		It("should render the definition for schedules.toml", func() {
+			def, _, err := LearnLineParser("8X", lines)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(FormatLineParser(def)).To(Equal(`[[schedules]]
+airlines = ["8X"]
+min_len = 9
+waypoint_start = 7
+fields = { 0 = "idx", 1 = "date", 2 = "number", 3 = "reg" }
+`))
+		})
+	})
+
+	Context("lines with an odd one out", func() {
+		It("should report the unmatched lines", func() {
+			lines := []string{
+				"L59 W/Z 8L9976 B6959 TSN/0510 CTU/0855 KMG",
+				"L60 W/Z 8L9977 B6960 KMG/0910 CTU/1200 TSN",
+				"L61 W/Z 8L9978 B6961 TSN/1300 KMG",
+				"8L9979 B6962 W/Z TSN/1500 KMG",
+			}
+			def, report, err := LearnLineParser("", lines)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(def.Fields).To(Equal(map[int]string{0: Index, 1: Task, 2: FlightNumber, 3: Register}))
+			Expect(def.WaypointStart).To(Equal(4))
+			Expect(def.MinLen).To(Equal(6))
+			Expect(report.Matched).To(Equal(3))
+			Expect(report.FieldHits[Task]).To(Equal(3))
+			Expect(report.Unmatched).To(Equal([]string{"8L9979 B6962 W/Z TSN/1500 KMG"}))
+		})
+	})
+
+	It("should fail without any schedule lines", func() {
+		_, _, err := LearnLineParser("XX", []string{"SCHEDULE FOR 30OCT", ""})
+		Expect(err).To(HaveOccurred())
+	})
+})
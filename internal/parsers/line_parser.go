@@ -0,0 +1,177 @@
+package parsers
+
+import (
+	"bytes"
+	"caatsm/pkg/utils"
+	_ "embed"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+// LineParser represents a line parser configuration.
+type LineParser struct {
+	Airlines      []string       `mapstructure:"airlines"`
+	MinLen        int            `mapstructure:"min_len"`
+	WaypointStart int            `mapstructure:"waypoint_start"`
+	Fields        map[int]string `mapstructure:"fields"`
+}
+
+// defaultDefinitions holds the built-in airline layouts.
+//
+//go:embed schedules.toml
+var defaultDefinitions []byte
+
+var (
+	airlineExpression = regexp.MustCompile(`^[0-9A-Z]{2}$`)
+
+	lineParsersOnce sync.Once
+	lineParsersMu   sync.RWMutex
+	parserDef       []LineParser
+)
+
+func loadDefaultLineParsers() {
+	lineParsersOnce.Do(func() {
+		defs, err := readLineParsers(viper.New(), "toml", defaultDefinitions)
+		if err == nil {
+			err = ValidateLineParsers(defs)
+		}
+		if err != nil {
+			utils.GetSugaredLogger().Errorf("invalid built-in schedule definitions: %v", err)
+			return
+		}
+		lineParsersMu.Lock()
+		defer lineParsersMu.Unlock()
+		parserDef = append(parserDef, defs...)
+	})
+}
+
+func readLineParsers(v *viper.Viper, configType string, data []byte) ([]LineParser, error) {
+	v.SetConfigType(configType)
+	if data != nil {
+		if err := v.ReadConfig(bytes.NewReader(data)); err != nil {
+			return nil, err
+		}
+	}
+	var defs []LineParser
+	if err := v.UnmarshalKey("schedules", &defs); err != nil {
+		return nil, err
+	}
+	return defs, nil
+}
+
+// LoadLineParsers reads schedule line definitions from a TOML or YAML file.
+// The file holds a "schedules" list with the same keys as the built-in
+// definitions: airlines, min_len, waypoint_start and fields.
+func LoadLineParsers(path string) ([]LineParser, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("error reading schedule definitions '%s': %v", path, err)
+	}
+	defs, err := readLineParsers(v, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode schedule definitions '%s': %v", path, err)
+	}
+	return defs, nil
+}
+
+// ValidateLineParsers checks that every definition can be applied to a line.
+func ValidateLineParsers(defs []LineParser) error {
+	seen := make(map[string]bool)
+	for i, def := range defs {
+		if len(def.Airlines) == 0 {
+			return fmt.Errorf("definition %d: airlines are required", i)
+		}
+		for _, airline := range def.Airlines {
+			if !airlineExpression.MatchString(airline) {
+				return fmt.Errorf("definition %d: invalid airline code %q", i, airline)
+			}
+			if seen[airline] {
+				return fmt.Errorf("definition %d: airline %s is defined twice", i, airline)
+			}
+			seen[airline] = true
+		}
+		if def.WaypointStart < 1 || def.WaypointStart >= def.MinLen {
+			return fmt.Errorf("definition %v: waypoint_start must be between 1 and min_len-1", def.Airlines)
+		}
+		hasFlightNumber := false
+		for position, field := range def.Fields {
+			if _, known := parserMap[field]; !known {
+				return fmt.Errorf("definition %v: unknown field %q", def.Airlines, field)
+			}
+			if position < 0 || position >= def.WaypointStart {
+				return fmt.Errorf("definition %v: field %s at %d is outside the line header", def.Airlines, field, position)
+			}
+			hasFlightNumber = hasFlightNumber || field == FlightNumber
+		}
+		if !hasFlightNumber {
+			return fmt.Errorf("definition %v: a %s field is required", def.Airlines, FlightNumber)
+		}
+	}
+	return nil
+}
+
+// RegisterLineParsers validates the definitions and installs them ahead of the
+// existing ones, so an airline can be added or its layout replaced at startup.
+func RegisterLineParsers(defs []LineParser) error {
+	if err := ValidateLineParsers(defs); err != nil {
+		return err
+	}
+	loadDefaultLineParsers()
+
+	lineParsersMu.Lock()
+	defer lineParsersMu.Unlock()
+	overridden := make(map[string]bool)
+	for _, def := range defs {
+		for _, airline := range def.Airlines {
+			overridden[airline] = true
+		}
+	}
+	result := append([]LineParser{}, defs...)
+	for _, def := range parserDef {
+		var airlines []string
+		for _, airline := range def.Airlines {
+			if !overridden[airline] {
+				airlines = append(airlines, airline)
+			}
+		}
+		if len(airlines) > 0 {
+			def.Airlines = airlines
+			result = append(result, def)
+		}
+	}
+	parserDef = result
+	return nil
+}
+
+// Airlines returns the airline codes that have a line definition.
+func Airlines() []string {
+	loadDefaultLineParsers()
+	lineParsersMu.RLock()
+	defer lineParsersMu.RUnlock()
+	var airlines []string
+	for _, def := range parserDef {
+		airlines = append(airlines, def.Airlines...)
+	}
+	sort.Strings(airlines)
+	return airlines
+}
+
+// FindDef returns the line definition of an airline, or nil if there is none.
+func FindDef(code string) *LineParser {
+	loadDefaultLineParsers()
+	lineParsersMu.RLock()
+	defer lineParsersMu.RUnlock()
+	for _, def := range parserDef {
+		for _, airline := range def.Airlines {
+			if airline == code {
+				return &def
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package parsers
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+var _ = Describe("Line Parser Definitions", func() {
+
+	Describe("Built-in definitions", func() {
+		It("should load every airline from the embedded file", func() {
+			Expect(Airlines()).To(ContainElements("FM", "MF", "8X", "HU", "EU"))
+		})
+
+		It("should be valid", func() {
+			defs, err := readLineParsers(viper.New(), "toml", defaultDefinitions)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(defs).To(HaveLen(19))
+			Expect(ValidateLineParsers(defs)).To(Succeed())
+		})
+	})
+
+	Describe("LoadLineParsers", func() {
+		It("should read a YAML file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "schedules.yaml")
+			content := `
+schedules:
+  - airlines: ["CA", "MU"]
+    min_len: 6
+    waypoint_start: 4
+    fields:
+      0: idx
+      1: number
+      2: reg
+`
+			Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+			defs, err := LoadLineParsers(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(defs).To(HaveLen(1))
+			Expect(defs[0].Airlines).To(Equal([]string{"CA", "MU"}))
+			Expect(defs[0].MinLen).To(Equal(6))
+			Expect(defs[0].WaypointStart).To(Equal(4))
+			Expect(defs[0].Fields).To(Equal(map[int]string{0: Index, 1: FlightNumber, 2: Register}))
+		})
+
+		It("should read the shipped configuration file", func() {
+			defs, err := LoadLineParsers("../../configs/schedules.toml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ValidateLineParsers(defs)).To(Succeed())
+		})
+
+		It("should fail on a missing file", func() {
+			_, err := LoadLineParsers(filepath.Join(GinkgoT().TempDir(), "missing.toml"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ValidateLineParsers", func() {
+		valid := func() LineParser {
+			return LineParser{
+				Airlines:      []string{"CA"},
+				MinLen:        6,
+				WaypointStart: 4,
+				Fields:        map[int]string{0: Index, 1: FlightNumber, 2: Register},
+			}
+		}
+
+		It("should accept a valid definition", func() {
+			Expect(ValidateLineParsers([]LineParser{valid()})).To(Succeed())
+		})
+
+		It("should reject an unknown field", func() {
+			def := valid()
+			def.Fields[3] = "gate"
+			Expect(ValidateLineParsers([]LineParser{def})).To(MatchError(ContainSubstring("unknown field")))
+		})
+
+		It("should reject a field inside the waypoints", func() {
+			def := valid()
+			def.Fields[4] = Date
+			Expect(ValidateLineParsers([]LineParser{def})).To(MatchError(ContainSubstring("outside the line header")))
+		})
+
+		It("should reject a definition without flight number", func() {
+			def := valid()
+			delete(def.Fields, 1)
+			Expect(ValidateLineParsers([]LineParser{def})).To(MatchError(ContainSubstring("number field is required")))
+		})
+
+		It("should reject an airline defined twice", func() {
+			Expect(ValidateLineParsers([]LineParser{valid(), valid()})).To(MatchError(ContainSubstring("defined twice")))
+		})
+
+		It("should reject a waypoint start beyond the minimum length", func() {
+			def := valid()
+			def.WaypointStart = 6
+			Expect(ValidateLineParsers([]LineParser{def})).To(HaveOccurred())
+		})
+	})
+
+	Describe("RegisterLineParsers", func() {
+		var saved []LineParser
+
+		BeforeEach(func() {
+			loadDefaultLineParsers()
+			saved = append([]LineParser{}, parserDef...)
+		})
+
+		AfterEach(func() {
+			parserDef = saved
+		})
+
+		It("should add a new airline and override an existing one", func() {
+			err := RegisterLineParsers([]LineParser{
+				{
+					Airlines:      []string{"CA"},
+					MinLen:        6,
+					WaypointStart: 4,
+					Fields:        map[int]string{0: Index, 1: FlightNumber, 2: Register},
+				},
+				{
+					Airlines:      []string{"MF"},
+					MinLen:        5,
+					WaypointStart: 3,
+					Fields:        map[int]string{0: FlightNumber, 1: Register},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			schedule := ParseWithDef("12) CA1371/1372/1527 B6513 A332 PEK0800 1030TSN", FindDef("CA"))
+			Expect(schedule.FlightNumber).To(Equal([]string{"CA1371", "CA1372", "CA1527"}))
+			Expect(schedule.AircraftReg).To(Equal("B6513"))
+			Expect(schedule.Waypoints).To(HaveLen(2))
+
+			Expect(FindDef("MF").WaypointStart).To(Equal(3))
+			Expect(FindDef("FM")).NotTo(BeNil())
+		})
+
+		It("should keep the existing definitions when validation fails", func() {
+			err := RegisterLineParsers([]LineParser{{Airlines: []string{"CA"}}})
+			Expect(err).To(HaveOccurred())
+			Expect(FindDef("CA")).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,222 @@
+package parsers
+
+import (
+	"caatsm/internal/domain"
+	"sort"
+	"strings"
+	"time"
+)
+
+// scheduleEntry is a flight line of a schedule document, with its continuation lines joined.
+type scheduleEntry struct {
+	lineNumber int
+	text       string
+}
+
+// ParseSchedule parses a whole schedule telegram. The AFTN/SITA envelope opening
+// it, blank lines and separators are dropped, the lines before the first flight make up
+// the title, and lines holding only waypoints are joined to the flight line
+// above them. Each flight line is parsed with the definition of the airline
+// detected from the title, or of its own flight number. Its local times are
+// resolved to UTC in the year closest to the reference time.
+func ParseSchedule(text string, reference time.Time) *domain.Schedule {
+	schedule := &domain.Schedule{Lines: []domain.ScheduleLine{}}
+	var (
+		title       []string
+		entries     []scheduleEntry
+		prefixVotes = make(map[string]int)
+	)
+
+	// the envelope can only open the document, and ends with its origin line
+	envelope, started := false, false
+	for i, raw := range strings.Split(text, "\n") {
+		line := strings.TrimSpace(raw)
+		if line == "" || line == "NNNN" || separatorExpression.MatchString(line) {
+			continue
+		}
+		if !started {
+			started = true
+			envelope = isEnvelopeStart(line)
+		}
+		if envelope && isEnvelopeLine(line) {
+			envelope = !isOriginLine(line)
+			continue
+		}
+		envelope = false
+		words := strings.Fields(line)
+		if layout, number := observeLayout(words); number != "" && (layout.waypointStart >= 0 || strings.Contains(line, CANCELLED)) {
+			prefixVotes[number[:2]]++
+			entries = append(entries, scheduleEntry{lineNumber: i + 1, text: line})
+			continue
+		}
+		if len(entries) > 0 && isWaypointRun(words) {
+			entries[len(entries)-1].text += " " + line
+			continue
+		}
+		if len(entries) == 0 {
+			title = append(title, line)
+			continue
+		}
+		schedule.Diagnostics = append(schedule.Diagnostics, domain.LineDiagnostic{
+			LineNumber: i + 1,
+			Status:     domain.LineSkipped,
+			Message:    "not a flight line",
+			Text:       line,
+		})
+		schedule.Summary.Skipped++
+	}
+
+	schedule.Title = strings.Join(title, "\n")
+	schedule.Airline = detectAirline(title, prefixVotes)
+	if match := documentDateExpression.FindStringSubmatch(schedule.Title); match != nil {
+		schedule.Date = match[1]
+	}
+
+	for _, entry := range entries {
+		line, message := parseEntry(entry.text, schedule.Airline)
+		status := domain.LineParsed
+		switch {
+		case line == nil:
+			status = domain.LineFailed
+		case line.Comments == CancelledComment:
+			status = domain.LineCancelled
+			message = "cancelled"
+		case line.Comments != "" || len(line.FlightNumber) == 0 || len(line.Waypoints) == 0:
+			status = domain.LineFailed
+			message = line.Comments
+		}
+
+		switch status {
+		case domain.LineParsed:
+			schedule.Summary.Parsed++
+		case domain.LineCancelled:
+			schedule.Summary.Cancelled++
+		case domain.LineFailed:
+			schedule.Summary.Failed++
+		}
+		if status != domain.LineParsed {
+			schedule.Diagnostics = append(schedule.Diagnostics, domain.LineDiagnostic{
+				LineNumber: entry.lineNumber,
+				Status:     status,
+				Message:    message,
+				Text:       entry.text,
+			})
+		}
+		if status == domain.LineFailed {
+			continue
+		}
+		if line.Date == "" {
+			line.Date = schedule.Date
+		}
+		ResolveTimes(line, reference)
+		schedule.Lines = append(schedule.Lines, *line)
+	}
+	schedule.Summary.Total = len(entries) + schedule.Summary.Skipped
+	sort.SliceStable(schedule.Diagnostics, func(i, j int) bool {
+		return schedule.Diagnostics[i].LineNumber < schedule.Diagnostics[j].LineNumber
+	})
+	return schedule
+}
+
+// parseEntry parses a flight line with the definition of the document airline,
+// then of the line's own flight number, falling back to ParseLine.
+func parseEntry(text, airline string) (*domain.ScheduleLine, string) {
+	def := FindDef(airline)
+	if def == nil {
+		if _, number := observeLayout(strings.Fields(text)); len(number) >= 2 {
+			def = FindDef(number[:2])
+		}
+	}
+	if def != nil {
+		return ParseWithDef(text, def), ""
+	}
+	line, err := ParseLine(text)
+	if err != nil {
+		return nil, err.Error()
+	}
+	return line, ""
+}
+
+// detectAirline returns the first airline code in the title that has a line
+// definition, or else the most common flight number prefix.
+func detectAirline(title []string, prefixVotes map[string]int) string {
+	for _, line := range title {
+		for _, word := range strings.Fields(line) {
+			if airlineExpression.MatchString(word) && FindDef(word) != nil {
+				return word
+			}
+		}
+	}
+	return mostCommon(prefixVotes)
+}
+
+// isEnvelopeStart reports whether a document opens with an AFTN or SITA
+// envelope: a start indicator or a priority followed by addresses
+func isEnvelopeStart(line string) bool {
+	words := strings.Fields(line)
+	if words[0] == StartIndicatorPrefix {
+		return true
+	}
+	return len(words) > 1 && (IsValidPriority(words[0]) || IsSITAPriority(words[0])) && isEnvelopeLine(line)
+}
+
+// isEnvelopeLine reports whether the line belongs to the AFTN or SITA envelope
+// around the schedule: start marker, priority, address and origin lines.
+func isEnvelopeLine(line string) bool {
+	words := strings.Fields(line)
+	switch {
+	case words[0] == StartIndicatorPrefix || isOriginLine(line):
+		return true
+	case IsValidPriority(words[0]) || IsSITAPriority(words[0]):
+		words = words[1:]
+		if len(words) == 0 {
+			return false
+		}
+	}
+	for _, word := range words {
+		if !envelopeAddressExpression.MatchString(word) {
+			return false
+		}
+	}
+	return true
+}
+
+// isOriginLine reports the line that ends the envelope: the AFTN filing time and
+// originator, or the SITA origin line starting with a dot
+func isOriginLine(line string) bool {
+	words := strings.Fields(line)
+	return strings.HasPrefix(line, EndHeaderMarker) ||
+		len(words) == 2 && FilingTimeExpression.MatchString(words[0]) && envelopeAddressExpression.MatchString(words[1])
+}
+
+// DetectSchedule parses the body of a telegram that is not an ATS message as a
+// schedule, dated from the filing time of the message. It returns nil when the
+// message has an ATS category or no flight line could be parsed from it.
+func DetectSchedule(message *domain.ParsedMessage) *domain.Schedule {
+	if message.Parsed {
+		return nil
+	}
+	text := message.Body
+	if text == "" {
+		text = message.Content
+	}
+	schedule := ParseSchedule(text, ReferenceTime(message))
+	if schedule.Summary.Parsed+schedule.Summary.Cancelled == 0 {
+		return nil
+	}
+	return schedule
+}
+
+// ParseTelegram parses a telegram as the listener does: as an ATS message or,
+// failing that, as a schedule, which is then the body data of the message and
+// returned as well
+func ParseTelegram(rawText string) (*domain.ParsedMessage, *domain.Schedule) {
+	parsed := Parse(rawText)
+	schedule := DetectSchedule(parsed)
+	if schedule != nil {
+		parsed.Parsed = true
+		parsed.Category = CategorySchedule
+		parsed.BodyData = schedule
+	}
+	return parsed, schedule
+}
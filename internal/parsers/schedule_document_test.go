@@ -0,0 +1,115 @@
+package parsers
+
+import (
+	"caatsm/internal/domain"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule Document", func() {
+	reference := time.Date(2024, time.October, 30, 12, 0, 0, 0, time.UTC)
+
+	Context("AFTN wrapped HU schedule", func() {
+		text := `ZCZC TAD123 301200
+GG ZBTJZPZX ZBTJKCHU
+301158 ZBTJHUXX
+HU TSN SCHEDULE FOR 31OCT
+====================
+L05 W/Z HU7205 B5406 (9) TSN/2355(30OCT) PVG
+L06 W/Z HU7206 B5406 (9) PVG/0300
+    TSN
+L07 W/Z HU7301 CNL
+REMARKS: ALL TIMES LOCAL
+L08 W/Z HU7401 B5407
+NNNN`
+		var schedule *domain.Schedule
+
+		BeforeEach(func() {
+			schedule = ParseSchedule(text, reference)
+		})
+
+		It("should detect the document header", func() {
+			Expect(schedule.Airline).To(Equal("HU"))
+			Expect(schedule.Date).To(Equal("31OCT"))
+			Expect(schedule.Title).To(Equal("HU TSN SCHEDULE FOR 31OCT"))
+		})
+
+		It("should parse the flight lines and join continuation lines", func() {
+			Expect(schedule.Lines).To(HaveLen(3))
+			Expect(schedule.Lines[0].FlightNumber).To(Equal([]string{"HU7205"}))
+			Expect(schedule.Lines[0].Date).To(Equal("31OCT"))
+			Expect(schedule.Lines[1].FlightNumber).To(Equal([]string{"HU7206"}))
+			Expect(schedule.Lines[1].Waypoints).To(HaveLen(2))
+			Expect(schedule.Lines[1].Waypoints[1].Airport).To(Equal("TSN"))
+			Expect(schedule.Lines[2].Comments).To(Equal(CancelledComment))
+		})
+
+		It("should report a diagnostic for every line not parsed", func() {
+			Expect(schedule.Summary).To(Equal(domain.ScheduleSummary{Total: 5, Parsed: 2, Cancelled: 1, Skipped: 2}))
+			Expect(schedule.Diagnostics).To(HaveLen(3))
+			Expect(schedule.Diagnostics[0].Status).To(Equal(domain.LineCancelled))
+			Expect(schedule.Diagnostics[0].LineNumber).To(Equal(9))
+			Expect(schedule.Diagnostics[1].Status).To(Equal(domain.LineSkipped))
+			Expect(schedule.Diagnostics[1].LineNumber).To(Equal(10))
+			Expect(schedule.Diagnostics[2].LineNumber).To(Equal(11))
+		})
+	})
+
+	It("should take the airline from the flight numbers without a title", func() {
+		schedule := ParseSchedule("L59 W/Z 8L9976 B6959 TSN/0510 CTU/0855 KMG\nL60 W/Z 8L9977 B6960 KMG/0910 CTU/1200 TSN", reference)
+		Expect(schedule.Airline).To(Equal("8L"))
+		Expect(schedule.Title).To(BeEmpty())
+		Expect(schedule.Summary.Parsed).To(Equal(2))
+	})
+
+	It("should recognise envelope lines", func() {
+		Expect(isEnvelopeLine("ZCZC TAD123 301200")).To(BeTrue())
+		Expect(isEnvelopeLine("QU PEKUOHU TSNKKHU")).To(BeTrue())
+		Expect(isEnvelopeLine(".TSNUOHU 301158")).To(BeTrue())
+		Expect(isEnvelopeLine("HU TSN SCHEDULE FOR 31OCT")).To(BeFalse())
+	})
+
+	It("should keep title lines that look like envelope lines after the envelope", func() {
+		schedule := ParseSchedule("QU TSNKKHU\n.PEKUOHU 301158\nTIANJIN AIRLINES\nSCHEDULE\nL05 W/Z HU7205 B5406 (9) TSN/2355(30OCT) PVG", reference)
+		Expect(schedule.Title).To(Equal("TIANJIN AIRLINES\nSCHEDULE"))
+		Expect(schedule.Summary.Parsed).To(Equal(1))
+	})
+
+	It("should not strip a document that does not open with an envelope", func() {
+		schedule := ParseSchedule("TIANJIN AIRLINES\n.NOTE TIMES LOCAL\nL05 W/Z HU7205 B5406 (9) TSN/2355(30OCT) PVG", reference)
+		Expect(schedule.Title).To(Equal("TIANJIN AIRLINES\n.NOTE TIMES LOCAL"))
+		Expect(schedule.Summary.Parsed).To(Equal(1))
+	})
+	Describe("DetectSchedule", func() {
+		It("should parse the body of an unparsed telegram", func() {
+			message := &domain.ParsedMessage{Body: "L05 W/Z HU7205 B5406 (9) TSN/2355(30OCT) PVG"}
+			schedule := DetectSchedule(message)
+			Expect(schedule).NotTo(BeNil())
+			Expect(schedule.Airline).To(Equal("HU"))
+		})
+
+		It("should ignore ATS messages and plain text", func() {
+			Expect(DetectSchedule(&domain.ParsedMessage{Parsed: true, Body: "L05 W/Z HU7205 B5406 (9) TSN/2355(30OCT) PVG"})).To(BeNil())
+			Expect(DetectSchedule(&domain.ParsedMessage{Body: "NO FLIGHTS TODAY"})).To(BeNil())
+		})
+	})
+	It("should be safe for concurrent use", func() {
+		text := "HU TSN SCHEDULE FOR 31OCT\nL05 W/Z HU7205 B5406 (9) TSN/2355(30OCT) PVG"
+		telegram := "ZCZC TMQ2611 151524\nGG ZBTJZPZX\n151524 ZBBBZGZX\n(ARR-CCA1532-ZSSS0730-ZBTJ0940)\nNNNN"
+		expected := Parse(telegram)
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				Expect(ParseSchedule(text, reference).Summary.Parsed).To(Equal(1))
+				Expect(Parse(telegram).ToString()).To(Equal(expected.ToString()))
+			}()
+		}
+		wg.Wait()
+	})
+})
@@ -1,6 +1,7 @@
 package parsers
 
 import (
+	"caatsm/internal/airports"
 	"caatsm/internal/domain"
 	"caatsm/pkg/utils"
 	"errors"
@@ -22,26 +23,16 @@ func ExtractWaypoint(message string) *domain.WayPoint {
 	}
 	result := &domain.WayPoint{
 		ArrivalTime:   data[ArrivalTime],
+		ArrivalDate:   data[ArrivalDate],
 		Airport:       data[AirportCode],
+		AirportICAO:   airports.ICAOFromIATA(data[AirportCode]),
 		DepartureTime: data[DepartureTime],
+		DepartureDate: data[DepartureDate],
 	}
 
 	return result
 }
 
-func FindDef(code string) *LineParser {
-	// fmt.Printf("Finding definition for %s\n", code)
-	// fmt.Println("ParserDef: ", parserDef)
-	for _, def := range *parserDef {
-		for _, airline := range def.Airlines {
-			if airline == code {
-				return &def
-			}
-		}
-	}
-	return nil
-}
-
 func standardizeSpaces(s string) string {
 	return strings.Join(strings.Fields(s), " ")
 }
@@ -54,7 +45,7 @@ func ParseWithDef(line string, parserDef *LineParser) *domain.ScheduleLine {
 		Reference: line,
 	}
 	if strings.Contains(line, CANCELLED) {
-		flightSchedule.Comments = "Cancelled"
+		flightSchedule.Comments = CancelledComment
 		return flightSchedule
 	}
 	// var result map[string]string
@@ -135,20 +126,32 @@ func parseFields(words []string, parseStrategy []string, flightSchedule *domain.
 	parsed := make(map[string]bool)
 	var maxParsed int
 
+	for name, i := range locateFields(words, parseStrategy) {
+		updateFlightSchedule(flightSchedule, name, extract(words[i], parserMap[name]))
+		parsed[name] = true
+		if i > maxParsed {
+			maxParsed = i
+		}
+	}
+	return parsed, maxParsed, nil
+}
+
+// locateFields returns the position of the first word matching each field of the strategy.
+// A word is claimed by the first field in the strategy that matches it.
+func locateFields(words []string, parseStrategy []string) map[string]int {
+	positions := make(map[string]int)
 	for i, word := range words {
 		for _, name := range parseStrategy {
-			if parsed[name] {
+			if _, found := positions[name]; found {
 				continue
 			}
-			if data := extract(word, parserMap[name]); data != nil {
-				updateFlightSchedule(flightSchedule, name, data)
-				parsed[name] = true
-				maxParsed = i
+			if extract(word, parserMap[name]) != nil {
+				positions[name] = i
 				break
 			}
 		}
 	}
-	return parsed, maxParsed, nil
+	return positions
 }
 
 // updateFlightSchedule updates the flight schedule based on the parsed data.
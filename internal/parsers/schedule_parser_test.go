@@ -154,8 +154,10 @@ var _ = Describe("Schedule Parser", func() {
 			message := "1845(11JUN)TSN/2100"
 			waypoint := ExtractWaypoint(message)
 			Expect(waypoint).NotTo(BeNil())
-			Expect(waypoint.ArrivalTime).To(Equal("1845(11JUN)"))
+			Expect(waypoint.ArrivalTime).To(Equal("1845"))
+			Expect(waypoint.ArrivalDate).To(Equal("11JUN"))
 			Expect(waypoint.Airport).To(Equal("TSN"))
+			Expect(waypoint.AirportICAO).To(Equal("ZBTJ"))
 			Expect(waypoint.DepartureTime).To(Equal("2100"))
 		})
 
@@ -291,7 +293,8 @@ var _ = Describe("Parse Line with PreDef", func() {
 			Expect(schedule.AircraftReg).To(Equal("B2863"))
 			Expect(len(schedule.Waypoints)).To(Equal(2))
 			Expect(schedule.Waypoints[0].Airport).To(Equal("TSN"))
-			Expect(schedule.Waypoints[0].DepartureTime).To(Equal("2350(28OCT)"))
+			Expect(schedule.Waypoints[0].DepartureTime).To(Equal("2350"))
+			Expect(schedule.Waypoints[0].DepartureDate).To(Equal("28OCT"))
 			Expect(schedule.Waypoints[1].Airport).To(Equal("HAK"))
 		})
 	})
@@ -310,7 +313,8 @@ var _ = Describe("Parse Line with PreDef", func() {
 			Expect(schedule.AircraftReg).To(Equal("B5406"))
 			Expect(len(schedule.Waypoints)).To(Equal(2))
 			Expect(schedule.Waypoints[0].Airport).To(Equal("TSN"))
-			Expect(schedule.Waypoints[0].DepartureTime).To(Equal("2355(30OCT)"))
+			Expect(schedule.Waypoints[0].DepartureTime).To(Equal("2355"))
+			Expect(schedule.Waypoints[0].DepartureDate).To(Equal("30OCT"))
 			Expect(schedule.Waypoints[1].Airport).To(Equal("PVG"))
 		})
 	})
@@ -347,7 +351,8 @@ var _ = Describe("Parse Line with PreDef", func() {
 			Expect(schedule.AircraftReg).To(Equal("B3193"))
 			Expect(len(schedule.Waypoints)).To(Equal(2))
 			Expect(schedule.Waypoints[0].Airport).To(Equal("XIY"))
-			Expect(schedule.Waypoints[0].DepartureTime).To(Equal("0020(16APR)"))
+			Expect(schedule.Waypoints[0].DepartureTime).To(Equal("0020"))
+			Expect(schedule.Waypoints[0].DepartureDate).To(Equal("16APR"))
 			Expect(schedule.Waypoints[1].Airport).To(Equal("CGD"))
 		})
 	})
@@ -403,7 +408,8 @@ var _ = Describe("Parse Line with PreDef", func() {
 			Expect(schedule.AircraftReg).To(Equal("B2076"))
 			Expect(len(schedule.Waypoints)).To(Equal(2))
 			Expect(schedule.Waypoints[0].Airport).To(Equal("PVG"))
-			Expect(schedule.Waypoints[0].DepartureTime).To(Equal("1535(30OCT)"))
+			Expect(schedule.Waypoints[0].DepartureTime).To(Equal("1535"))
+			Expect(schedule.Waypoints[0].DepartureDate).To(Equal("30OCT"))
 			Expect(schedule.Waypoints[1].Airport).To(Equal("TPE"))
 			Expect(schedule.Waypoints[1].ArrivalTime).To(Equal("1705"))
 		})
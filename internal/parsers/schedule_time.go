@@ -0,0 +1,119 @@
+package parsers
+
+import (
+	"caatsm/internal/airports"
+	"caatsm/internal/domain"
+	"strconv"
+	"time"
+)
+
+// ResolveTimes converts the local waypoint times of a schedule line to UTC.
+// Waypoints without a date suffix use the line date; a time earlier than the
+// previous one on the route is taken to be on the next day. The year is the
+// one that puts the date closest to the reference time.
+func ResolveTimes(schedule *domain.ScheduleLine, reference time.Time) {
+	lineDate, _ := parseDayMonth(schedule.Date, reference)
+	var previous time.Time
+	for i := range schedule.Waypoints {
+		waypoint := &schedule.Waypoints[i]
+		location := airports.LocationByIATA(waypoint.Airport)
+		waypoint.ArrivalUTC, previous = resolveTime(waypoint.ArrivalTime, waypoint.ArrivalDate, lineDate, previous, location, reference)
+		waypoint.DepartureUTC, previous = resolveTime(waypoint.DepartureTime, waypoint.DepartureDate, lineDate, previous, location, reference)
+	}
+}
+
+// resolveTime returns the UTC time of a local HHMM time, and the instant to
+// compare the next time on the route against.
+func resolveTime(hhmm, date string, lineDate, previous time.Time, location *time.Location, reference time.Time) (*time.Time, time.Time) {
+	if hhmm == "" || location == nil {
+		return nil, previous
+	}
+	clock, err := time.Parse("1504", hhmm)
+	if err != nil {
+		return nil, previous
+	}
+	day := lineDate
+	if date != "" {
+		if day, err = parseDayMonth(date, reference); err != nil {
+			return nil, previous
+		}
+	}
+	if day.IsZero() {
+		return nil, previous
+	}
+
+	local := time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), 0, 0, location)
+	if date == "" && !previous.IsZero() && local.Before(previous) {
+		local = local.AddDate(0, 0, 1)
+	}
+	utc := local.UTC()
+	return &utc, local
+}
+
+// ReferenceTime returns the time to resolve the schedule dates of a telegram
+// against: its filing time, in the month it was received or the one before, so
+// a telegram parsed again after New Year keeps the year it was filed in. It is
+// the receipt time without a filing time, and the current time without either.
+func ReferenceTime(message *domain.ParsedMessage) time.Time {
+	received := message.ReceivedAt
+	if received.IsZero() {
+		received = time.Now()
+	}
+	for _, value := range []string{message.OriginatorDateTime, message.DateTime} {
+		if filed, ok := filingTime(value, received); ok {
+			return filed
+		}
+	}
+	return received
+}
+
+// filingTime returns the latest UTC time of a DDHHMM filing time that is not
+// more than a day after the time the telegram was received.
+func filingTime(value string, received time.Time) (time.Time, bool) {
+	data := extract(value, FilingTimeExpression)
+	if data == nil {
+		return time.Time{}, false
+	}
+	day, _ := strconv.Atoi(data["day"])
+	hour, _ := strconv.Atoi(data["hour"])
+	minute, _ := strconv.Atoi(data["minute"])
+	latest := received.UTC().Add(24 * time.Hour)
+	for months := 0; months < 3; months++ {
+		candidate := time.Date(latest.Year(), latest.Month()-time.Month(months), day, hour, minute, 0, 0, time.UTC)
+		if candidate.Day() == day && !candidate.After(latest) {
+			return candidate, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseDayMonth parses a schedule date such as "30OCT", picking the year
+// closest to the reference time.
+func parseDayMonth(date string, reference time.Time) (time.Time, error) {
+	if date == "" {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse("02Jan", date)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var best time.Time
+	for _, year := range []int{reference.Year() - 1, reference.Year(), reference.Year() + 1} {
+		candidate := time.Date(year, parsed.Month(), parsed.Day(), 0, 0, 0, 0, time.UTC)
+		if candidate.Day() != parsed.Day() {
+			// 29FEB outside a leap year
+			continue
+		}
+		if best.IsZero() || absDuration(candidate.Sub(reference)) < absDuration(best.Sub(reference)) {
+			best = candidate
+		}
+	}
+	return best, nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,103 @@
+package parsers
+
+import (
+	"caatsm/internal/domain"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule Times", func() {
+	reference := time.Date(2024, time.October, 29, 12, 0, 0, 0, time.UTC)
+
+	Describe("parseDayMonth", func() {
+		It("should pick the year closest to the reference", func() {
+			date, err := parseDayMonth("30OCT", reference)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(date).To(Equal(time.Date(2024, time.October, 30, 0, 0, 0, 0, time.UTC)))
+
+			date, err = parseDayMonth("02JAN", time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(date.Year()).To(Equal(2025))
+		})
+
+		It("should reject an invalid date", func() {
+			_, err := parseDayMonth("32OCT", reference)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ReferenceTime", func() {
+		It("should keep the year a telegram was filed in when it is parsed after New Year", func() {
+			message := &domain.ParsedMessage{
+				OriginatorDateTime: "311000",
+				ReceivedAt:         time.Date(2025, time.January, 2, 8, 0, 0, 0, time.UTC),
+			}
+			Expect(ReferenceTime(message)).To(Equal(time.Date(2024, time.December, 31, 10, 0, 0, 0, time.UTC)))
+		})
+
+		It("should use the filing time of the start indicator, then the receipt time", func() {
+			received := time.Date(2024, time.October, 29, 12, 0, 0, 0, time.UTC)
+			Expect(ReferenceTime(&domain.ParsedMessage{DateTime: "291158", ReceivedAt: received})).
+				To(Equal(time.Date(2024, time.October, 29, 11, 58, 0, 0, time.UTC)))
+			Expect(ReferenceTime(&domain.ParsedMessage{ReceivedAt: received})).To(Equal(received))
+		})
+
+		It("should date a schedule from the filing time rather than the clock", func() {
+			message := &domain.ParsedMessage{
+				OriginatorDateTime: "301158",
+				ReceivedAt:         time.Date(2025, time.January, 3, 8, 0, 0, 0, time.UTC),
+				Body:               "L05 W/Z HU7205 B5406 (9) TSN/2355(31DEC) PVG",
+			}
+			schedule := DetectSchedule(message)
+			Expect(schedule).NotTo(BeNil())
+			Expect(*schedule.Lines[0].Waypoints[0].DepartureUTC).To(Equal(time.Date(2024, time.December, 31, 15, 55, 0, 0, time.UTC)))
+		})
+	})
+
+	Describe("ResolveTimes", func() {
+		It("should convert Beijing local times to UTC", func() {
+			schedule := ParseWithDef("L1:  29OCT  BK2735 B2863  ILS  IS (3/6)  TSN2350(28OCT)   1235HAK", FindDef("8X"))
+			ResolveTimes(schedule, reference)
+			Expect(*schedule.Waypoints[0].DepartureUTC).To(Equal(time.Date(2024, time.October, 28, 15, 50, 0, 0, time.UTC)))
+			Expect(*schedule.Waypoints[1].ArrivalUTC).To(Equal(time.Date(2024, time.October, 29, 4, 35, 0, 0, time.UTC)))
+		})
+
+		It("should roll over to the next day when the arrival is past midnight", func() {
+			schedule := &domain.ScheduleLine{
+				Date: "31OCT",
+				Waypoints: []domain.WayPoint{
+					{Airport: "SZX", DepartureTime: "2345"},
+					{Airport: "TSN", ArrivalTime: "0255"},
+				},
+			}
+			ResolveTimes(schedule, reference)
+			Expect(*schedule.Waypoints[0].DepartureUTC).To(Equal(time.Date(2024, time.October, 31, 15, 45, 0, 0, time.UTC)))
+			Expect(*schedule.Waypoints[1].ArrivalUTC).To(Equal(time.Date(2024, time.October, 31, 18, 55, 0, 0, time.UTC)))
+		})
+
+		It("should use the airport's own time zone", func() {
+			schedule := &domain.ScheduleLine{
+				Date: "30OCT",
+				Waypoints: []domain.WayPoint{
+					{Airport: "ICN", DepartureTime: "0235"},
+				},
+			}
+			ResolveTimes(schedule, reference)
+			Expect(*schedule.Waypoints[0].DepartureUTC).To(Equal(time.Date(2024, time.October, 29, 17, 35, 0, 0, time.UTC)))
+		})
+
+		It("should leave times unresolved without a date or a known airport", func() {
+			schedule := &domain.ScheduleLine{
+				Waypoints: []domain.WayPoint{
+					{Airport: "TSN", DepartureTime: "0100"},
+					{Airport: "XXX", ArrivalTime: "0300", ArrivalDate: "30OCT"},
+				},
+			}
+			ResolveTimes(schedule, reference)
+			Expect(schedule.Waypoints[0].DepartureUTC).To(BeNil())
+			Expect(schedule.Waypoints[1].ArrivalUTC).To(BeNil())
+		})
+	})
+})
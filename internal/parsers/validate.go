@@ -0,0 +1,39 @@
+package parsers
+
+import (
+	"caatsm/internal/domain"
+	"fmt"
+	"strings"
+)
+
+// RejectedError reports a telegram that cannot be processed, however often it is delivered
+type RejectedError struct {
+	Stage  string
+	Reason string
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("rejected at %s: %s", e.Stage, e.Reason)
+}
+
+// Validate rejects a message that was not parsed, or an ATS message with an
+// invalid priority, primary address or originator. A SITA Type-B header, with a
+// Q priority and 7-character addresses, is valid. Its header warnings, such as
+// an invalid secondary address, are published with it.
+func Validate(parsed *domain.ParsedMessage, schedule *domain.Schedule) *RejectedError {
+	if !parsed.Parsed {
+		reason := parsed.Comments
+		if reason == "" {
+			reason = "unrecognised message"
+		}
+		return &RejectedError{Stage: domain.StageParse, Reason: reason}
+	}
+	if schedule == nil && !parsed.HeaderValid() {
+		reasons := make([]string, 0, len(parsed.HeaderErrors))
+		for _, headerError := range parsed.HeaderErrors {
+			reasons = append(reasons, headerError.Error())
+		}
+		return &RejectedError{Stage: domain.StageValidation, Reason: strings.Join(reasons, "; ")}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package parsers
+
+import (
+	"caatsm/internal/domain"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validate", func() {
+	arr := `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+
+	It("should accept a parsed telegram with a valid header", func() {
+		Expect(Validate(ParseTelegram(arr))).To(BeNil())
+	})
+
+	It("should accept a SITA telegram", func() {
+		Expect(Validate(ParseTelegram(`ZCZC TMQ2611 151524
+QU TSNZPCA
+.HAKUOHU 151234
+(FPL-CHH7670-IS
+-B733/M-SDHIRW/S
+-ZBTJ1440
+-M074S0980 CG A326 VYK
+-ZJHK0323 ZGNN ZJSY
+-REG/B2113 SEL/DGEH RMK/ACAS EQPT)
+NNNN`))).To(BeNil())
+	})
+
+	It("should reject a telegram that was not parsed", func() {
+		rejected := Validate(ParseTelegram("NOT A TELEGRAM"))
+		Expect(rejected).NotTo(BeNil())
+		Expect(rejected.Stage).To(Equal(domain.StageParse))
+	})
+
+	It("should reject an invalid originator but not an invalid secondary address", func() {
+		rejected := Validate(ParseTelegram(`ZCZC TMQ2530 141614
+GG ZBTJZXZX PEKUDCA
+141614 ZSHC
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`))
+		Expect(rejected).NotTo(BeNil())
+		Expect(rejected.Stage).To(Equal(domain.StageValidation))
+		Expect(rejected.Reason).To(ContainSubstring(FieldOriginator))
+		Expect(rejected.Reason).NotTo(ContainSubstring(FieldSecondary))
+		Expect(rejected.Error()).To(HavePrefix("rejected at validation: "))
+	})
+})
@@ -0,0 +1,242 @@
+package pb
+
+import (
+	"caatsm/internal/domain"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromParsedMessage returns the protobuf form of a parsed message
+func FromParsedMessage(m *domain.ParsedMessage) *ParsedMessage {
+	message := &ParsedMessage{
+		SchemaVersion:      m.SchemaVersion,
+		Uuid:               m.Uuid,
+		MessageId:          m.MessageID,
+		DateTime:           m.DateTime,
+		PriorityIndicator:  m.PriorityIndicator,
+		PrimaryAddress:     m.PrimaryAddress,
+		SecondaryAddresses: m.SecondaryAddresses,
+		Originator:         m.Originator,
+		OriginatorDateTime: m.OriginatorDateTime,
+		Category:           m.Category,
+		Body:               m.Body,
+		Content:            m.Content,
+		ReceivedAt:         timestamp(m.ReceivedAt),
+		ParsedAt:           timestamp(m.ParsedAt),
+		DispatchedAt:       timestamp(m.DispatchedAt),
+		NeedDispatch:       m.NeedDispatch,
+		Parsed:             m.Parsed,
+		Comments:           m.Comments,
+		TraceContext:       m.TraceContext,
+	}
+	for _, airport := range m.Airports {
+		message.Airports = append(message.Airports, &Airport{
+			Icao:      airport.ICAO,
+			Iata:      airport.IATA,
+			Name:      airport.Name,
+			Country:   airport.Country,
+			Latitude:  airport.Latitude,
+			Longitude: airport.Longitude,
+			Elevation: int32(airport.Elevation),
+			Timezone:  airport.Timezone,
+		})
+	}
+	for _, headerError := range m.HeaderErrors {
+		message.HeaderErrors = append(message.HeaderErrors, &HeaderError{
+			Field:  headerError.Field,
+			Value:  headerError.Value,
+			Reason: headerError.Reason,
+		})
+	}
+	for _, headerWarning := range m.HeaderWarnings {
+		message.HeaderWarnings = append(message.HeaderWarnings, &HeaderError{
+			Field:  headerWarning.Field,
+			Value:  headerWarning.Value,
+			Reason: headerWarning.Reason,
+		})
+	}
+
+	switch b := m.BodyData.(type) {
+	case *domain.ARR:
+		message.BodyData = &ParsedMessage_Arr{Arr: &ARR{
+			Category:             b.Category,
+			AircraftId:           b.AircraftID,
+			SsrModeAndCode:       b.SSRModeAndCode,
+			DepartureAirport:     b.DepartureAirport,
+			DepartureTime:        b.DepartureTime,
+			ArrivalAirport:       b.ArrivalAirport,
+			ArrivalTime:          b.ArrivalTime,
+			EstimatedElapsedTime: b.EstimatedElapsedTime,
+			AlternateAirport:     b.AlternateAirport,
+			OtherInfo:            b.OtherInfo,
+		}}
+	case *domain.DEP:
+		message.BodyData = &ParsedMessage_Dep{Dep: &DEP{
+			Category:             b.Category,
+			AircraftId:           b.AircraftID,
+			SsrModeAndCode:       b.SSRModeAndCode,
+			DepartureAirport:     b.DepartureAirport,
+			DepartureTime:        b.DepartureTime,
+			Destination:          b.Destination,
+			EstimatedElapsedTime: b.EstimatedElapsedTime,
+			AlternateAirport:     b.AlternateAirport,
+			OtherInfo:            b.OtherInfo,
+		}}
+	case *domain.CNL:
+		message.BodyData = &ParsedMessage_Cnl{Cnl: &CNL{
+			Category:           b.Category,
+			AircraftId:         b.AircraftID,
+			DepartureAirport:   b.DepartureAirport,
+			DestinationAirport: b.DestinationAirport,
+			OtherInfo:          b.OtherInfo,
+		}}
+	case *domain.DLA:
+		message.BodyData = &ParsedMessage_Dla{Dla: &DLA{
+			Category:         b.Category,
+			AircraftId:       b.AircraftID,
+			SsrModeAndCode:   b.SSRModeAndCode,
+			DepartureAirport: b.DepartureAirport,
+			NewDepartureTime: b.NewDepartureTime,
+			ArrivalAirport:   b.ArrivalAirport,
+			ArrivalTime:      b.ArrivalTime,
+			OtherInfo:        b.OtherInfo,
+		}}
+	case *domain.CHG:
+		message.BodyData = &ParsedMessage_Chg{Chg: &CHG{
+			Category:             b.Category,
+			AircraftId:           b.AircraftID,
+			SsrModeAndCode:       b.SSRModeAndCode,
+			DepartureAirport:     b.DepartureAirport,
+			DepartureTime:        b.DepartureTime,
+			ArrivalAirport:       b.ArrivalAirport,
+			ArrivalTime:          b.ArrivalTime,
+			EstimatedElapsedTime: b.EstimatedElapsedTime,
+			AlternateAirport:     b.AlternateAirport,
+			OtherInfo:            b.OtherInfo,
+			ChangePart:           b.ChangePart,
+		}}
+	case *domain.FPL:
+		message.BodyData = &ParsedMessage_Fpl{Fpl: &FPL{
+			Category:                b.Category,
+			FlightNumber:            b.FlightNumber,
+			ReferenceData:           b.ReferenceData,
+			AircraftId:              b.AircraftID,
+			SsrModeAndCode:          b.SSRModeAndCode,
+			FlightRulesAndType:      b.FlightRulesAndType,
+			CruisingSpeedAndLevel:   b.CruisingSpeedAndLevel,
+			DepartureAirport:        b.DepartureAirport,
+			DepartureTime:           b.DepartureTime,
+			Route:                   b.Route,
+			DestinationAndTotalTime: b.DestinationAndTotalTime,
+			AlternateAirport:        b.AlternateAirport,
+			OtherInfo:               b.OtherInfo,
+			SupplementaryInfo:       b.SupplementaryInfo,
+			EstimatedArrivalTime:    b.EstimatedArrivalTime,
+			Pbn:                     b.PBN,
+			NavigationEquipment:     b.NavigationEquipment,
+			EstimatedElapsedTime:    b.EstimatedElapsedTime,
+			SelcalCode:              b.SELCALCode,
+			Register:                b.Register,
+			PerformanceCategory:     b.PerformanceCategory,
+			RerouteInformation:      b.RerouteInformation,
+			Remarks:                 b.Remarks,
+		}}
+	case *domain.CPL:
+		message.BodyData = &ParsedMessage_Cpl{Cpl: &CPL{
+			Category:                b.Category,
+			AircraftId:              b.AircraftID,
+			SsrModeAndCode:          b.SSRModeAndCode,
+			FlightRulesAndType:      b.FlightRulesAndType,
+			AircraftAndEquipment:    b.AircraftAndEquipment,
+			CruisingSpeedAndLevel:   b.CruisingSpeedAndLevel,
+			DepartureAirport:        b.DepartureAirport,
+			DepartureTime:           b.DepartureTime,
+			Route:                   b.Route,
+			DestinationAndTotalTime: b.DestinationAndTotalTime,
+			AlternateAirport:        b.AlternateAirport,
+			OtherInfo:               b.OtherInfo,
+		}}
+	case *domain.ALN:
+		message.BodyData = &ParsedMessage_Aln{Aln: &ALN{
+			Category:           b.Category,
+			AircraftId:         b.AircraftID,
+			SsrModeAndCode:     b.SSRModeAndCode,
+			FlightRulesAndType: b.FlightRulesAndType,
+			DepartureAirport:   b.DepartureAirport,
+			DepartureTime:      b.DepartureTime,
+			ArrivalAirport:     b.ArrivalAirport,
+			ArrivalTime:        b.ArrivalTime,
+			OtherInfo:          b.OtherInfo,
+		}}
+	case *domain.Schedule:
+		message.BodyData = &ParsedMessage_Schedule{Schedule: FromSchedule(b)}
+	}
+	return message
+}
+
+// FromSchedule returns the protobuf form of a schedule
+func FromSchedule(s *domain.Schedule) *Schedule {
+	schedule := &Schedule{
+		Airline: s.Airline,
+		Date:    s.Date,
+		Title:   s.Title,
+		Summary: &ScheduleSummary{
+			Total:     int32(s.Summary.Total),
+			Parsed:    int32(s.Summary.Parsed),
+			Cancelled: int32(s.Summary.Cancelled),
+			Failed:    int32(s.Summary.Failed),
+			Skipped:   int32(s.Summary.Skipped),
+		},
+	}
+	for _, line := range s.Lines {
+		scheduleLine := &ScheduleLine{
+			Index:           line.Index,
+			Date:            line.Date,
+			Task:            line.Task,
+			FlightNumber:    line.FlightNumber,
+			AircraftReg:     line.AircraftReg,
+			PassengerConfig: line.PassengerConfig,
+			Ils:             line.ILS,
+			Comments:        line.Comments,
+			Reference:       line.Reference,
+		}
+		for _, waypoint := range line.Waypoints {
+			scheduleLine.Waypoints = append(scheduleLine.Waypoints, &WayPoint{
+				ArrivalTime:   waypoint.ArrivalTime,
+				ArrivalDate:   waypoint.ArrivalDate,
+				ArrivalUtc:    timestampOf(waypoint.ArrivalUTC),
+				Airport:       waypoint.Airport,
+				AirportIcao:   waypoint.AirportICAO,
+				DepartureTime: waypoint.DepartureTime,
+				DepartureDate: waypoint.DepartureDate,
+				DepartureUtc:  timestampOf(waypoint.DepartureUTC),
+			})
+		}
+		schedule.Lines = append(schedule.Lines, scheduleLine)
+	}
+	for _, diagnostic := range s.Diagnostics {
+		schedule.Diagnostics = append(schedule.Diagnostics, &LineDiagnostic{
+			LineNumber: int32(diagnostic.LineNumber),
+			Status:     diagnostic.Status,
+			Message:    diagnostic.Message,
+			Text:       diagnostic.Text,
+		})
+	}
+	return schedule
+}
+
+// timestamp returns nil for the zero time
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func timestampOf(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamp(*t)
+}
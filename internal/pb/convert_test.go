@@ -0,0 +1,65 @@
+package pb
+
+import (
+	"caatsm/internal/domain"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("Convert", func() {
+	It("should convert a parsed flight plan", func() {
+		received := time.Date(2024, 10, 14, 16, 14, 0, 0, time.UTC)
+		message := &domain.ParsedMessage{
+			SchemaVersion: domain.SchemaVersion,
+			Uuid:          "uuid-1",
+			MessageID:     "TMQ2530",
+			Originator:    "ZSHCZTZX",
+			Category:      "FPL",
+			Parsed:        true,
+			ReceivedAt:    received,
+			Airports:      []domain.Airport{{ICAO: "ZSSS", Elevation: 10}},
+			HeaderErrors:  []domain.HeaderError{{Field: "priorityIndicator", Value: "XX", Reason: "unknown"}},
+			BodyData:      &domain.FPL{Category: "FPL", FlightNumber: "CES5470", SELCALCode: "JLAD", PBN: "A1B2"},
+		}
+		data, err := proto.Marshal(FromParsedMessage(message))
+		Expect(err).NotTo(HaveOccurred())
+
+		decoded := &ParsedMessage{}
+		Expect(proto.Unmarshal(data, decoded)).To(Succeed())
+		Expect(decoded.GetSchemaVersion()).To(Equal(domain.SchemaVersion))
+		Expect(decoded.GetMessageId()).To(Equal("TMQ2530"))
+		Expect(decoded.GetReceivedAt().AsTime()).To(Equal(received))
+		Expect(decoded.GetParsedAt()).To(BeNil())
+		Expect(decoded.GetAirports()[0].GetElevation()).To(Equal(int32(10)))
+		Expect(decoded.GetHeaderErrors()[0].GetReason()).To(Equal("unknown"))
+		Expect(decoded.GetFpl().GetFlightNumber()).To(Equal("CES5470"))
+		Expect(decoded.GetFpl().GetSelcalCode()).To(Equal("JLAD"))
+		Expect(decoded.GetArr()).To(BeNil())
+	})
+
+	It("should convert a schedule with its lines", func() {
+		departure := time.Date(2024, 10, 30, 15, 55, 0, 0, time.UTC)
+		schedule := &domain.Schedule{
+			Airline: "HU",
+			Lines: []domain.ScheduleLine{{
+				Date:         "30OCT",
+				FlightNumber: []string{"HU7205"},
+				AircraftReg:  "B5406",
+				Waypoints:    []domain.WayPoint{{Airport: "TSN", DepartureTime: "2355", DepartureUTC: &departure}, {Airport: "PVG"}},
+			}},
+			Diagnostics: []domain.LineDiagnostic{{LineNumber: 7, Status: domain.LineFailed, Text: "???"}},
+			Summary:     domain.ScheduleSummary{Total: 2, Parsed: 1, Failed: 1},
+		}
+		message := FromParsedMessage(&domain.ParsedMessage{Category: "SCHEDULE", BodyData: schedule})
+		converted := message.GetSchedule()
+		Expect(converted.GetAirline()).To(Equal("HU"))
+		Expect(converted.GetLines()[0].GetFlightNumber()).To(Equal([]string{"HU7205"}))
+		Expect(converted.GetLines()[0].GetWaypoints()[0].GetDepartureUtc().AsTime()).To(Equal(departure))
+		Expect(converted.GetLines()[0].GetWaypoints()[1].GetDepartureUtc()).To(BeNil())
+		Expect(converted.GetDiagnostics()[0].GetLineNumber()).To(Equal(int32(7)))
+		Expect(converted.GetSummary().GetFailed()).To(Equal(int32(1)))
+	})
+})
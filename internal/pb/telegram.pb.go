@@ -0,0 +1,2902 @@
+// Protobuf form of the published messages, an alternative to their JSON
+// encoding. Field names follow the JSON keys of the domain types.
+//
+// Regenerate telegram.pb.go and telegram_grpc.pb.go with 'task proto'.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: internal/pb/telegram.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SubscribeRequest filters the streamed telegrams. A telegram matches when it
+// matches every filter given, any of its values; an empty filter matches all.
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories    []string `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`                            // 类别, e.g. 'FPL'
+	Originators   []string `protobuf:"bytes,2,rep,name=originators,proto3" json:"originators,omitempty"`                          // 发件人
+	Airports      []string `protobuf:"bytes,3,rep,name=airports,proto3" json:"airports,omitempty"`                                // 机场: ICAO or IATA code of an airport of the route
+	FlightNumbers []string `protobuf:"bytes,4,rep,name=flight_numbers,json=flightNumbers,proto3" json:"flight_numbers,omitempty"` // 航班号: flight number or aircraft identification
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetOriginators() []string {
+	if x != nil {
+		return x.Originators
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetAirports() []string {
+	if x != nil {
+		return x.Airports
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetFlightNumbers() []string {
+	if x != nil {
+		return x.FlightNumbers
+	}
+	return nil
+}
+
+type ParseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"` // 报文: the raw telegram
+}
+
+func (x *ParseRequest) Reset() {
+	*x = ParseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseRequest) ProtoMessage() {}
+
+func (x *ParseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseRequest.ProtoReflect.Descriptor instead.
+func (*ParseRequest) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParseRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// ParsedMessage is a telegram with its header fields and parsed body
+type ParsedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchemaVersion      string                 `protobuf:"bytes,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`                                                                                       // 模式版本
+	Uuid               string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                                                                                              // 标识
+	MessageId          string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                                                                                                   // 信息ID
+	DateTime           string                 `protobuf:"bytes,4,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`                                                                                                      // 日期时间
+	PriorityIndicator  string                 `protobuf:"bytes,5,opt,name=priority_indicator,json=priorityIndicator,proto3" json:"priority_indicator,omitempty"`                                                                           // 优先级标识
+	PrimaryAddress     string                 `protobuf:"bytes,6,opt,name=primary_address,json=primaryAddress,proto3" json:"primary_address,omitempty"`                                                                                    // 主要地址
+	SecondaryAddresses []string               `protobuf:"bytes,7,rep,name=secondary_addresses,json=secondaryAddresses,proto3" json:"secondary_addresses,omitempty"`                                                                        // 次要地址
+	Originator         string                 `protobuf:"bytes,8,opt,name=originator,proto3" json:"originator,omitempty"`                                                                                                                  // 发件人
+	OriginatorDateTime string                 `protobuf:"bytes,9,opt,name=originator_date_time,json=originatorDateTime,proto3" json:"originator_date_time,omitempty"`                                                                      // 发件日期时间
+	Category           string                 `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`                                                                                                                     // 类别
+	Body               string                 `protobuf:"bytes,11,opt,name=body,proto3" json:"body,omitempty"`                                                                                                                             // 正文和页脚
+	Content            string                 `protobuf:"bytes,12,opt,name=content,proto3" json:"content,omitempty"`                                                                                                                       // 正文
+	Airports           []*Airport             `protobuf:"bytes,13,rep,name=airports,proto3" json:"airports,omitempty"`                                                                                                                     // 机场
+	ReceivedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`                                                                                               // 接收时间
+	ParsedAt           *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=parsed_at,json=parsedAt,proto3" json:"parsed_at,omitempty"`                                                                                                     // 解析时间
+	DispatchedAt       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=dispatched_at,json=dispatchedAt,proto3" json:"dispatched_at,omitempty"`                                                                                         // 分发时间
+	NeedDispatch       bool                   `protobuf:"varint,17,opt,name=need_dispatch,json=needDispatch,proto3" json:"need_dispatch,omitempty"`                                                                                        // 需要分发
+	Parsed             bool                   `protobuf:"varint,18,opt,name=parsed,proto3" json:"parsed,omitempty"`                                                                                                                        // 解析
+	Comments           string                 `protobuf:"bytes,19,opt,name=comments,proto3" json:"comments,omitempty"`                                                                                                                     // 备注
+	HeaderErrors       []*HeaderError         `protobuf:"bytes,20,rep,name=header_errors,json=headerErrors,proto3" json:"header_errors,omitempty"`                                                                                         // 报头错误
+	TraceContext       map[string]string      `protobuf:"bytes,21,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 追踪上下文
+	HeaderWarnings     []*HeaderError         `protobuf:"bytes,22,rep,name=header_warnings,json=headerWarnings,proto3" json:"header_warnings,omitempty"`                                                                                   // 报头警告
+	// 正文数据: the body of a parsed telegram, by category
+	//
+	// Types that are assignable to BodyData:
+	//	*ParsedMessage_Arr
+	//	*ParsedMessage_Dep
+	//	*ParsedMessage_Cnl
+	//	*ParsedMessage_Dla
+	//	*ParsedMessage_Chg
+	//	*ParsedMessage_Fpl
+	//	*ParsedMessage_Cpl
+	//	*ParsedMessage_Aln
+	//	*ParsedMessage_Schedule
+	BodyData isParsedMessage_BodyData `protobuf_oneof:"body_data"`
+}
+
+func (x *ParsedMessage) Reset() {
+	*x = ParsedMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParsedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsedMessage) ProtoMessage() {}
+
+func (x *ParsedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsedMessage.ProtoReflect.Descriptor instead.
+func (*ParsedMessage) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ParsedMessage) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *ParsedMessage) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ParsedMessage) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ParsedMessage) GetDateTime() string {
+	if x != nil {
+		return x.DateTime
+	}
+	return ""
+}
+
+func (x *ParsedMessage) GetPriorityIndicator() string {
+	if x != nil {
+		return x.PriorityIndicator
+	}
+	return ""
+}
+
+func (x *ParsedMessage) GetPrimaryAddress() string {
+	if x != nil {
+		return x.PrimaryAddress
+	}
+	return ""
+}
+
+func (x *ParsedMessage) GetSecondaryAddresses() []string {
+	if x != nil {
+		return x.SecondaryAddresses
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetOriginator() string {
+	if x != nil {
+		return x.Originator
+	}
+	return ""
+}
+
+func (x *ParsedMessage) GetOriginatorDateTime() string {
+	if x != nil {
+		return x.OriginatorDateTime
+	}
+	return ""
+}
+
+func (x *ParsedMessage) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ParsedMessage) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ParsedMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ParsedMessage) GetAirports() []*Airport {
+	if x != nil {
+		return x.Airports
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetParsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ParsedAt
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetDispatchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DispatchedAt
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetNeedDispatch() bool {
+	if x != nil {
+		return x.NeedDispatch
+	}
+	return false
+}
+
+func (x *ParsedMessage) GetParsed() bool {
+	if x != nil {
+		return x.Parsed
+	}
+	return false
+}
+
+func (x *ParsedMessage) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+func (x *ParsedMessage) GetHeaderErrors() []*HeaderError {
+	if x != nil {
+		return x.HeaderErrors
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetTraceContext() map[string]string {
+	if x != nil {
+		return x.TraceContext
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetHeaderWarnings() []*HeaderError {
+	if x != nil {
+		return x.HeaderWarnings
+	}
+	return nil
+}
+
+func (m *ParsedMessage) GetBodyData() isParsedMessage_BodyData {
+	if m != nil {
+		return m.BodyData
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetArr() *ARR {
+	if x, ok := x.GetBodyData().(*ParsedMessage_Arr); ok {
+		return x.Arr
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetDep() *DEP {
+	if x, ok := x.GetBodyData().(*ParsedMessage_Dep); ok {
+		return x.Dep
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetCnl() *CNL {
+	if x, ok := x.GetBodyData().(*ParsedMessage_Cnl); ok {
+		return x.Cnl
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetDla() *DLA {
+	if x, ok := x.GetBodyData().(*ParsedMessage_Dla); ok {
+		return x.Dla
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetChg() *CHG {
+	if x, ok := x.GetBodyData().(*ParsedMessage_Chg); ok {
+		return x.Chg
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetFpl() *FPL {
+	if x, ok := x.GetBodyData().(*ParsedMessage_Fpl); ok {
+		return x.Fpl
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetCpl() *CPL {
+	if x, ok := x.GetBodyData().(*ParsedMessage_Cpl); ok {
+		return x.Cpl
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetAln() *ALN {
+	if x, ok := x.GetBodyData().(*ParsedMessage_Aln); ok {
+		return x.Aln
+	}
+	return nil
+}
+
+func (x *ParsedMessage) GetSchedule() *Schedule {
+	if x, ok := x.GetBodyData().(*ParsedMessage_Schedule); ok {
+		return x.Schedule
+	}
+	return nil
+}
+
+type isParsedMessage_BodyData interface {
+	isParsedMessage_BodyData()
+}
+
+type ParsedMessage_Arr struct {
+	Arr *ARR `protobuf:"bytes,30,opt,name=arr,proto3,oneof"`
+}
+
+type ParsedMessage_Dep struct {
+	Dep *DEP `protobuf:"bytes,31,opt,name=dep,proto3,oneof"`
+}
+
+type ParsedMessage_Cnl struct {
+	Cnl *CNL `protobuf:"bytes,32,opt,name=cnl,proto3,oneof"`
+}
+
+type ParsedMessage_Dla struct {
+	Dla *DLA `protobuf:"bytes,33,opt,name=dla,proto3,oneof"`
+}
+
+type ParsedMessage_Chg struct {
+	Chg *CHG `protobuf:"bytes,34,opt,name=chg,proto3,oneof"`
+}
+
+type ParsedMessage_Fpl struct {
+	Fpl *FPL `protobuf:"bytes,35,opt,name=fpl,proto3,oneof"`
+}
+
+type ParsedMessage_Cpl struct {
+	Cpl *CPL `protobuf:"bytes,36,opt,name=cpl,proto3,oneof"`
+}
+
+type ParsedMessage_Aln struct {
+	Aln *ALN `protobuf:"bytes,37,opt,name=aln,proto3,oneof"`
+}
+
+type ParsedMessage_Schedule struct {
+	Schedule *Schedule `protobuf:"bytes,38,opt,name=schedule,proto3,oneof"`
+}
+
+func (*ParsedMessage_Arr) isParsedMessage_BodyData() {}
+
+func (*ParsedMessage_Dep) isParsedMessage_BodyData() {}
+
+func (*ParsedMessage_Cnl) isParsedMessage_BodyData() {}
+
+func (*ParsedMessage_Dla) isParsedMessage_BodyData() {}
+
+func (*ParsedMessage_Chg) isParsedMessage_BodyData() {}
+
+func (*ParsedMessage_Fpl) isParsedMessage_BodyData() {}
+
+func (*ParsedMessage_Cpl) isParsedMessage_BodyData() {}
+
+func (*ParsedMessage_Aln) isParsedMessage_BodyData() {}
+
+func (*ParsedMessage_Schedule) isParsedMessage_BodyData() {}
+
+type HeaderError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *HeaderError) Reset() {
+	*x = HeaderError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaderError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderError) ProtoMessage() {}
+
+func (x *HeaderError) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderError.ProtoReflect.Descriptor instead.
+func (*HeaderError) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HeaderError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *HeaderError) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *HeaderError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type Airport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Icao      string  `protobuf:"bytes,1,opt,name=icao,proto3" json:"icao,omitempty"`
+	Iata      string  `protobuf:"bytes,2,opt,name=iata,proto3" json:"iata,omitempty"`
+	Name      string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Country   string  `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Elevation int32   `protobuf:"varint,7,opt,name=elevation,proto3" json:"elevation,omitempty"`
+	Timezone  string  `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *Airport) Reset() {
+	*x = Airport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Airport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Airport) ProtoMessage() {}
+
+func (x *Airport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Airport.ProtoReflect.Descriptor instead.
+func (*Airport) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Airport) GetIcao() string {
+	if x != nil {
+		return x.Icao
+	}
+	return ""
+}
+
+func (x *Airport) GetIata() string {
+	if x != nil {
+		return x.Iata
+	}
+	return ""
+}
+
+func (x *Airport) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Airport) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Airport) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Airport) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Airport) GetElevation() int32 {
+	if x != nil {
+		return x.Elevation
+	}
+	return 0
+}
+
+func (x *Airport) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// ARR arrival message
+type ARR struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category             string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	AircraftId           string `protobuf:"bytes,2,opt,name=aircraft_id,json=aircraftId,proto3" json:"aircraft_id,omitempty"`
+	SsrModeAndCode       string `protobuf:"bytes,3,opt,name=ssr_mode_and_code,json=ssrModeAndCode,proto3" json:"ssr_mode_and_code,omitempty"`
+	DepartureAirport     string `protobuf:"bytes,4,opt,name=departure_airport,json=departureAirport,proto3" json:"departure_airport,omitempty"`
+	DepartureTime        string `protobuf:"bytes,5,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	ArrivalAirport       string `protobuf:"bytes,6,opt,name=arrival_airport,json=arrivalAirport,proto3" json:"arrival_airport,omitempty"`
+	ArrivalTime          string `protobuf:"bytes,7,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	EstimatedElapsedTime string `protobuf:"bytes,8,opt,name=estimated_elapsed_time,json=estimatedElapsedTime,proto3" json:"estimated_elapsed_time,omitempty"`
+	AlternateAirport     string `protobuf:"bytes,9,opt,name=alternate_airport,json=alternateAirport,proto3" json:"alternate_airport,omitempty"`
+	OtherInfo            string `protobuf:"bytes,10,opt,name=other_info,json=otherInfo,proto3" json:"other_info,omitempty"`
+}
+
+func (x *ARR) Reset() {
+	*x = ARR{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ARR) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ARR) ProtoMessage() {}
+
+func (x *ARR) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ARR.ProtoReflect.Descriptor instead.
+func (*ARR) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ARR) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ARR) GetAircraftId() string {
+	if x != nil {
+		return x.AircraftId
+	}
+	return ""
+}
+
+func (x *ARR) GetSsrModeAndCode() string {
+	if x != nil {
+		return x.SsrModeAndCode
+	}
+	return ""
+}
+
+func (x *ARR) GetDepartureAirport() string {
+	if x != nil {
+		return x.DepartureAirport
+	}
+	return ""
+}
+
+func (x *ARR) GetDepartureTime() string {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return ""
+}
+
+func (x *ARR) GetArrivalAirport() string {
+	if x != nil {
+		return x.ArrivalAirport
+	}
+	return ""
+}
+
+func (x *ARR) GetArrivalTime() string {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return ""
+}
+
+func (x *ARR) GetEstimatedElapsedTime() string {
+	if x != nil {
+		return x.EstimatedElapsedTime
+	}
+	return ""
+}
+
+func (x *ARR) GetAlternateAirport() string {
+	if x != nil {
+		return x.AlternateAirport
+	}
+	return ""
+}
+
+func (x *ARR) GetOtherInfo() string {
+	if x != nil {
+		return x.OtherInfo
+	}
+	return ""
+}
+
+// DEP departure message
+type DEP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category             string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	AircraftId           string `protobuf:"bytes,2,opt,name=aircraft_id,json=aircraftId,proto3" json:"aircraft_id,omitempty"`
+	SsrModeAndCode       string `protobuf:"bytes,3,opt,name=ssr_mode_and_code,json=ssrModeAndCode,proto3" json:"ssr_mode_and_code,omitempty"`
+	DepartureAirport     string `protobuf:"bytes,4,opt,name=departure_airport,json=departureAirport,proto3" json:"departure_airport,omitempty"`
+	DepartureTime        string `protobuf:"bytes,5,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	Destination          string `protobuf:"bytes,6,opt,name=destination,proto3" json:"destination,omitempty"`
+	EstimatedElapsedTime string `protobuf:"bytes,7,opt,name=estimated_elapsed_time,json=estimatedElapsedTime,proto3" json:"estimated_elapsed_time,omitempty"`
+	AlternateAirport     string `protobuf:"bytes,8,opt,name=alternate_airport,json=alternateAirport,proto3" json:"alternate_airport,omitempty"`
+	OtherInfo            string `protobuf:"bytes,9,opt,name=other_info,json=otherInfo,proto3" json:"other_info,omitempty"`
+}
+
+func (x *DEP) Reset() {
+	*x = DEP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DEP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DEP) ProtoMessage() {}
+
+func (x *DEP) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DEP.ProtoReflect.Descriptor instead.
+func (*DEP) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DEP) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *DEP) GetAircraftId() string {
+	if x != nil {
+		return x.AircraftId
+	}
+	return ""
+}
+
+func (x *DEP) GetSsrModeAndCode() string {
+	if x != nil {
+		return x.SsrModeAndCode
+	}
+	return ""
+}
+
+func (x *DEP) GetDepartureAirport() string {
+	if x != nil {
+		return x.DepartureAirport
+	}
+	return ""
+}
+
+func (x *DEP) GetDepartureTime() string {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return ""
+}
+
+func (x *DEP) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *DEP) GetEstimatedElapsedTime() string {
+	if x != nil {
+		return x.EstimatedElapsedTime
+	}
+	return ""
+}
+
+func (x *DEP) GetAlternateAirport() string {
+	if x != nil {
+		return x.AlternateAirport
+	}
+	return ""
+}
+
+func (x *DEP) GetOtherInfo() string {
+	if x != nil {
+		return x.OtherInfo
+	}
+	return ""
+}
+
+// CNL cancellation message
+type CNL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category           string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	AircraftId         string `protobuf:"bytes,2,opt,name=aircraft_id,json=aircraftId,proto3" json:"aircraft_id,omitempty"`
+	DepartureAirport   string `protobuf:"bytes,3,opt,name=departure_airport,json=departureAirport,proto3" json:"departure_airport,omitempty"`
+	DestinationAirport string `protobuf:"bytes,4,opt,name=destination_airport,json=destinationAirport,proto3" json:"destination_airport,omitempty"`
+	OtherInfo          string `protobuf:"bytes,5,opt,name=other_info,json=otherInfo,proto3" json:"other_info,omitempty"`
+}
+
+func (x *CNL) Reset() {
+	*x = CNL{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CNL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CNL) ProtoMessage() {}
+
+func (x *CNL) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CNL.ProtoReflect.Descriptor instead.
+func (*CNL) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CNL) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CNL) GetAircraftId() string {
+	if x != nil {
+		return x.AircraftId
+	}
+	return ""
+}
+
+func (x *CNL) GetDepartureAirport() string {
+	if x != nil {
+		return x.DepartureAirport
+	}
+	return ""
+}
+
+func (x *CNL) GetDestinationAirport() string {
+	if x != nil {
+		return x.DestinationAirport
+	}
+	return ""
+}
+
+func (x *CNL) GetOtherInfo() string {
+	if x != nil {
+		return x.OtherInfo
+	}
+	return ""
+}
+
+// DLA delay message
+type DLA struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category         string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	AircraftId       string `protobuf:"bytes,2,opt,name=aircraft_id,json=aircraftId,proto3" json:"aircraft_id,omitempty"`
+	SsrModeAndCode   string `protobuf:"bytes,3,opt,name=ssr_mode_and_code,json=ssrModeAndCode,proto3" json:"ssr_mode_and_code,omitempty"`
+	DepartureAirport string `protobuf:"bytes,4,opt,name=departure_airport,json=departureAirport,proto3" json:"departure_airport,omitempty"`
+	NewDepartureTime string `protobuf:"bytes,5,opt,name=new_departure_time,json=newDepartureTime,proto3" json:"new_departure_time,omitempty"`
+	ArrivalAirport   string `protobuf:"bytes,6,opt,name=arrival_airport,json=arrivalAirport,proto3" json:"arrival_airport,omitempty"`
+	// Kept under its JSON key, estimated_elapsed_time
+	ArrivalTime string `protobuf:"bytes,7,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	OtherInfo   string `protobuf:"bytes,8,opt,name=other_info,json=otherInfo,proto3" json:"other_info,omitempty"`
+}
+
+func (x *DLA) Reset() {
+	*x = DLA{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DLA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLA) ProtoMessage() {}
+
+func (x *DLA) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLA.ProtoReflect.Descriptor instead.
+func (*DLA) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DLA) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *DLA) GetAircraftId() string {
+	if x != nil {
+		return x.AircraftId
+	}
+	return ""
+}
+
+func (x *DLA) GetSsrModeAndCode() string {
+	if x != nil {
+		return x.SsrModeAndCode
+	}
+	return ""
+}
+
+func (x *DLA) GetDepartureAirport() string {
+	if x != nil {
+		return x.DepartureAirport
+	}
+	return ""
+}
+
+func (x *DLA) GetNewDepartureTime() string {
+	if x != nil {
+		return x.NewDepartureTime
+	}
+	return ""
+}
+
+func (x *DLA) GetArrivalAirport() string {
+	if x != nil {
+		return x.ArrivalAirport
+	}
+	return ""
+}
+
+func (x *DLA) GetArrivalTime() string {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return ""
+}
+
+func (x *DLA) GetOtherInfo() string {
+	if x != nil {
+		return x.OtherInfo
+	}
+	return ""
+}
+
+// CHG modification message
+type CHG struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category             string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	AircraftId           string `protobuf:"bytes,2,opt,name=aircraft_id,json=aircraftId,proto3" json:"aircraft_id,omitempty"`
+	SsrModeAndCode       string `protobuf:"bytes,3,opt,name=ssr_mode_and_code,json=ssrModeAndCode,proto3" json:"ssr_mode_and_code,omitempty"`
+	DepartureAirport     string `protobuf:"bytes,4,opt,name=departure_airport,json=departureAirport,proto3" json:"departure_airport,omitempty"`
+	DepartureTime        string `protobuf:"bytes,5,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	ArrivalAirport       string `protobuf:"bytes,6,opt,name=arrival_airport,json=arrivalAirport,proto3" json:"arrival_airport,omitempty"`
+	ArrivalTime          string `protobuf:"bytes,7,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	EstimatedElapsedTime string `protobuf:"bytes,8,opt,name=estimated_elapsed_time,json=estimatedElapsedTime,proto3" json:"estimated_elapsed_time,omitempty"`
+	AlternateAirport     string `protobuf:"bytes,9,opt,name=alternate_airport,json=alternateAirport,proto3" json:"alternate_airport,omitempty"`
+	OtherInfo            string `protobuf:"bytes,10,opt,name=other_info,json=otherInfo,proto3" json:"other_info,omitempty"`
+	ChangePart           string `protobuf:"bytes,11,opt,name=change_part,json=changePart,proto3" json:"change_part,omitempty"`
+}
+
+func (x *CHG) Reset() {
+	*x = CHG{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CHG) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CHG) ProtoMessage() {}
+
+func (x *CHG) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CHG.ProtoReflect.Descriptor instead.
+func (*CHG) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CHG) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CHG) GetAircraftId() string {
+	if x != nil {
+		return x.AircraftId
+	}
+	return ""
+}
+
+func (x *CHG) GetSsrModeAndCode() string {
+	if x != nil {
+		return x.SsrModeAndCode
+	}
+	return ""
+}
+
+func (x *CHG) GetDepartureAirport() string {
+	if x != nil {
+		return x.DepartureAirport
+	}
+	return ""
+}
+
+func (x *CHG) GetDepartureTime() string {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return ""
+}
+
+func (x *CHG) GetArrivalAirport() string {
+	if x != nil {
+		return x.ArrivalAirport
+	}
+	return ""
+}
+
+func (x *CHG) GetArrivalTime() string {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return ""
+}
+
+func (x *CHG) GetEstimatedElapsedTime() string {
+	if x != nil {
+		return x.EstimatedElapsedTime
+	}
+	return ""
+}
+
+func (x *CHG) GetAlternateAirport() string {
+	if x != nil {
+		return x.AlternateAirport
+	}
+	return ""
+}
+
+func (x *CHG) GetOtherInfo() string {
+	if x != nil {
+		return x.OtherInfo
+	}
+	return ""
+}
+
+func (x *CHG) GetChangePart() string {
+	if x != nil {
+		return x.ChangePart
+	}
+	return ""
+}
+
+// FPL filed flight plan message
+type FPL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category                string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	FlightNumber            string `protobuf:"bytes,2,opt,name=flight_number,json=flightNumber,proto3" json:"flight_number,omitempty"`
+	ReferenceData           string `protobuf:"bytes,3,opt,name=reference_data,json=referenceData,proto3" json:"reference_data,omitempty"`
+	AircraftId              string `protobuf:"bytes,4,opt,name=aircraft_id,json=aircraftId,proto3" json:"aircraft_id,omitempty"`
+	SsrModeAndCode          string `protobuf:"bytes,5,opt,name=ssr_mode_and_code,json=ssrModeAndCode,proto3" json:"ssr_mode_and_code,omitempty"`
+	FlightRulesAndType      string `protobuf:"bytes,6,opt,name=flight_rules_and_type,json=flightRulesAndType,proto3" json:"flight_rules_and_type,omitempty"`
+	CruisingSpeedAndLevel   string `protobuf:"bytes,7,opt,name=cruising_speed_and_level,json=cruisingSpeedAndLevel,proto3" json:"cruising_speed_and_level,omitempty"`
+	DepartureAirport        string `protobuf:"bytes,8,opt,name=departure_airport,json=departureAirport,proto3" json:"departure_airport,omitempty"`
+	DepartureTime           string `protobuf:"bytes,9,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	Route                   string `protobuf:"bytes,10,opt,name=route,proto3" json:"route,omitempty"`
+	DestinationAndTotalTime string `protobuf:"bytes,11,opt,name=destination_and_total_time,json=destinationAndTotalTime,proto3" json:"destination_and_total_time,omitempty"`
+	AlternateAirport        string `protobuf:"bytes,12,opt,name=alternate_airport,json=alternateAirport,proto3" json:"alternate_airport,omitempty"`
+	OtherInfo               string `protobuf:"bytes,13,opt,name=other_info,json=otherInfo,proto3" json:"other_info,omitempty"`
+	SupplementaryInfo       string `protobuf:"bytes,14,opt,name=supplementary_info,json=supplementaryInfo,proto3" json:"supplementary_info,omitempty"`
+	EstimatedArrivalTime    string `protobuf:"bytes,15,opt,name=estimated_arrival_time,json=estimatedArrivalTime,proto3" json:"estimated_arrival_time,omitempty"`
+	Pbn                     string `protobuf:"bytes,16,opt,name=pbn,proto3" json:"pbn,omitempty"`
+	NavigationEquipment     string `protobuf:"bytes,17,opt,name=navigation_equipment,json=navigationEquipment,proto3" json:"navigation_equipment,omitempty"`
+	EstimatedElapsedTime    string `protobuf:"bytes,18,opt,name=estimated_elapsed_time,json=estimatedElapsedTime,proto3" json:"estimated_elapsed_time,omitempty"`
+	SelcalCode              string `protobuf:"bytes,19,opt,name=selcal_code,json=selcalCode,proto3" json:"selcal_code,omitempty"`
+	Register                string `protobuf:"bytes,20,opt,name=register,proto3" json:"register,omitempty"`
+	PerformanceCategory     string `protobuf:"bytes,21,opt,name=performance_category,json=performanceCategory,proto3" json:"performance_category,omitempty"`
+	RerouteInformation      string `protobuf:"bytes,22,opt,name=reroute_information,json=rerouteInformation,proto3" json:"reroute_information,omitempty"`
+	Remarks                 string `protobuf:"bytes,23,opt,name=remarks,proto3" json:"remarks,omitempty"`
+}
+
+func (x *FPL) Reset() {
+	*x = FPL{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FPL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FPL) ProtoMessage() {}
+
+func (x *FPL) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FPL.ProtoReflect.Descriptor instead.
+func (*FPL) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FPL) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *FPL) GetFlightNumber() string {
+	if x != nil {
+		return x.FlightNumber
+	}
+	return ""
+}
+
+func (x *FPL) GetReferenceData() string {
+	if x != nil {
+		return x.ReferenceData
+	}
+	return ""
+}
+
+func (x *FPL) GetAircraftId() string {
+	if x != nil {
+		return x.AircraftId
+	}
+	return ""
+}
+
+func (x *FPL) GetSsrModeAndCode() string {
+	if x != nil {
+		return x.SsrModeAndCode
+	}
+	return ""
+}
+
+func (x *FPL) GetFlightRulesAndType() string {
+	if x != nil {
+		return x.FlightRulesAndType
+	}
+	return ""
+}
+
+func (x *FPL) GetCruisingSpeedAndLevel() string {
+	if x != nil {
+		return x.CruisingSpeedAndLevel
+	}
+	return ""
+}
+
+func (x *FPL) GetDepartureAirport() string {
+	if x != nil {
+		return x.DepartureAirport
+	}
+	return ""
+}
+
+func (x *FPL) GetDepartureTime() string {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return ""
+}
+
+func (x *FPL) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *FPL) GetDestinationAndTotalTime() string {
+	if x != nil {
+		return x.DestinationAndTotalTime
+	}
+	return ""
+}
+
+func (x *FPL) GetAlternateAirport() string {
+	if x != nil {
+		return x.AlternateAirport
+	}
+	return ""
+}
+
+func (x *FPL) GetOtherInfo() string {
+	if x != nil {
+		return x.OtherInfo
+	}
+	return ""
+}
+
+func (x *FPL) GetSupplementaryInfo() string {
+	if x != nil {
+		return x.SupplementaryInfo
+	}
+	return ""
+}
+
+func (x *FPL) GetEstimatedArrivalTime() string {
+	if x != nil {
+		return x.EstimatedArrivalTime
+	}
+	return ""
+}
+
+func (x *FPL) GetPbn() string {
+	if x != nil {
+		return x.Pbn
+	}
+	return ""
+}
+
+func (x *FPL) GetNavigationEquipment() string {
+	if x != nil {
+		return x.NavigationEquipment
+	}
+	return ""
+}
+
+func (x *FPL) GetEstimatedElapsedTime() string {
+	if x != nil {
+		return x.EstimatedElapsedTime
+	}
+	return ""
+}
+
+func (x *FPL) GetSelcalCode() string {
+	if x != nil {
+		return x.SelcalCode
+	}
+	return ""
+}
+
+func (x *FPL) GetRegister() string {
+	if x != nil {
+		return x.Register
+	}
+	return ""
+}
+
+func (x *FPL) GetPerformanceCategory() string {
+	if x != nil {
+		return x.PerformanceCategory
+	}
+	return ""
+}
+
+func (x *FPL) GetRerouteInformation() string {
+	if x != nil {
+		return x.RerouteInformation
+	}
+	return ""
+}
+
+func (x *FPL) GetRemarks() string {
+	if x != nil {
+		return x.Remarks
+	}
+	return ""
+}
+
+// CPL current flight plan message
+type CPL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category                string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	AircraftId              string `protobuf:"bytes,2,opt,name=aircraft_id,json=aircraftId,proto3" json:"aircraft_id,omitempty"`
+	SsrModeAndCode          string `protobuf:"bytes,3,opt,name=ssr_mode_and_code,json=ssrModeAndCode,proto3" json:"ssr_mode_and_code,omitempty"`
+	FlightRulesAndType      string `protobuf:"bytes,4,opt,name=flight_rules_and_type,json=flightRulesAndType,proto3" json:"flight_rules_and_type,omitempty"`
+	AircraftAndEquipment    string `protobuf:"bytes,5,opt,name=aircraft_and_equipment,json=aircraftAndEquipment,proto3" json:"aircraft_and_equipment,omitempty"`
+	CruisingSpeedAndLevel   string `protobuf:"bytes,6,opt,name=cruising_speed_and_level,json=cruisingSpeedAndLevel,proto3" json:"cruising_speed_and_level,omitempty"`
+	DepartureAirport        string `protobuf:"bytes,7,opt,name=departure_airport,json=departureAirport,proto3" json:"departure_airport,omitempty"`
+	DepartureTime           string `protobuf:"bytes,8,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	Route                   string `protobuf:"bytes,9,opt,name=route,proto3" json:"route,omitempty"`
+	DestinationAndTotalTime string `protobuf:"bytes,10,opt,name=destination_and_total_time,json=destinationAndTotalTime,proto3" json:"destination_and_total_time,omitempty"`
+	AlternateAirport        string `protobuf:"bytes,11,opt,name=alternate_airport,json=alternateAirport,proto3" json:"alternate_airport,omitempty"`
+	OtherInfo               string `protobuf:"bytes,12,opt,name=other_info,json=otherInfo,proto3" json:"other_info,omitempty"`
+}
+
+func (x *CPL) Reset() {
+	*x = CPL{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CPL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPL) ProtoMessage() {}
+
+func (x *CPL) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPL.ProtoReflect.Descriptor instead.
+func (*CPL) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CPL) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CPL) GetAircraftId() string {
+	if x != nil {
+		return x.AircraftId
+	}
+	return ""
+}
+
+func (x *CPL) GetSsrModeAndCode() string {
+	if x != nil {
+		return x.SsrModeAndCode
+	}
+	return ""
+}
+
+func (x *CPL) GetFlightRulesAndType() string {
+	if x != nil {
+		return x.FlightRulesAndType
+	}
+	return ""
+}
+
+func (x *CPL) GetAircraftAndEquipment() string {
+	if x != nil {
+		return x.AircraftAndEquipment
+	}
+	return ""
+}
+
+func (x *CPL) GetCruisingSpeedAndLevel() string {
+	if x != nil {
+		return x.CruisingSpeedAndLevel
+	}
+	return ""
+}
+
+func (x *CPL) GetDepartureAirport() string {
+	if x != nil {
+		return x.DepartureAirport
+	}
+	return ""
+}
+
+func (x *CPL) GetDepartureTime() string {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return ""
+}
+
+func (x *CPL) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *CPL) GetDestinationAndTotalTime() string {
+	if x != nil {
+		return x.DestinationAndTotalTime
+	}
+	return ""
+}
+
+func (x *CPL) GetAlternateAirport() string {
+	if x != nil {
+		return x.AlternateAirport
+	}
+	return ""
+}
+
+func (x *CPL) GetOtherInfo() string {
+	if x != nil {
+		return x.OtherInfo
+	}
+	return ""
+}
+
+// ALN alerting message
+type ALN struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category           string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	AircraftId         string `protobuf:"bytes,2,opt,name=aircraft_id,json=aircraftId,proto3" json:"aircraft_id,omitempty"`
+	SsrModeAndCode     string `protobuf:"bytes,3,opt,name=ssr_mode_and_code,json=ssrModeAndCode,proto3" json:"ssr_mode_and_code,omitempty"`
+	FlightRulesAndType string `protobuf:"bytes,4,opt,name=flight_rules_and_type,json=flightRulesAndType,proto3" json:"flight_rules_and_type,omitempty"`
+	DepartureAirport   string `protobuf:"bytes,5,opt,name=departure_airport,json=departureAirport,proto3" json:"departure_airport,omitempty"`
+	DepartureTime      string `protobuf:"bytes,6,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	ArrivalAirport     string `protobuf:"bytes,7,opt,name=arrival_airport,json=arrivalAirport,proto3" json:"arrival_airport,omitempty"`
+	ArrivalTime        string `protobuf:"bytes,8,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	OtherInfo          string `protobuf:"bytes,9,opt,name=other_info,json=otherInfo,proto3" json:"other_info,omitempty"`
+}
+
+func (x *ALN) Reset() {
+	*x = ALN{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ALN) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ALN) ProtoMessage() {}
+
+func (x *ALN) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ALN.ProtoReflect.Descriptor instead.
+func (*ALN) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ALN) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ALN) GetAircraftId() string {
+	if x != nil {
+		return x.AircraftId
+	}
+	return ""
+}
+
+func (x *ALN) GetSsrModeAndCode() string {
+	if x != nil {
+		return x.SsrModeAndCode
+	}
+	return ""
+}
+
+func (x *ALN) GetFlightRulesAndType() string {
+	if x != nil {
+		return x.FlightRulesAndType
+	}
+	return ""
+}
+
+func (x *ALN) GetDepartureAirport() string {
+	if x != nil {
+		return x.DepartureAirport
+	}
+	return ""
+}
+
+func (x *ALN) GetDepartureTime() string {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return ""
+}
+
+func (x *ALN) GetArrivalAirport() string {
+	if x != nil {
+		return x.ArrivalAirport
+	}
+	return ""
+}
+
+func (x *ALN) GetArrivalTime() string {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return ""
+}
+
+func (x *ALN) GetOtherInfo() string {
+	if x != nil {
+		return x.OtherInfo
+	}
+	return ""
+}
+
+// Schedule is a flight schedule telegram
+type Schedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Airline     string            `protobuf:"bytes,1,opt,name=airline,proto3" json:"airline,omitempty"`
+	Date        string            `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Title       string            `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Lines       []*ScheduleLine   `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	Diagnostics []*LineDiagnostic `protobuf:"bytes,5,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Summary     *ScheduleSummary  `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Schedule) GetAirline() string {
+	if x != nil {
+		return x.Airline
+	}
+	return ""
+}
+
+func (x *Schedule) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Schedule) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Schedule) GetLines() []*ScheduleLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Schedule) GetDiagnostics() []*LineDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *Schedule) GetSummary() *ScheduleSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type ScheduleLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index           string      `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Date            string      `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Task            string      `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	FlightNumber    []string    `protobuf:"bytes,4,rep,name=flight_number,json=flightNumber,proto3" json:"flight_number,omitempty"`
+	AircraftReg     string      `protobuf:"bytes,5,opt,name=aircraft_reg,json=aircraftReg,proto3" json:"aircraft_reg,omitempty"`
+	PassengerConfig string      `protobuf:"bytes,6,opt,name=passenger_config,json=passengerConfig,proto3" json:"passenger_config,omitempty"`
+	Ils             string      `protobuf:"bytes,7,opt,name=ils,proto3" json:"ils,omitempty"`
+	Waypoints       []*WayPoint `protobuf:"bytes,8,rep,name=waypoints,proto3" json:"waypoints,omitempty"`
+	Comments        string      `protobuf:"bytes,9,opt,name=comments,proto3" json:"comments,omitempty"`
+	Reference       string      `protobuf:"bytes,10,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (x *ScheduleLine) Reset() {
+	*x = ScheduleLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleLine) ProtoMessage() {}
+
+func (x *ScheduleLine) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleLine.ProtoReflect.Descriptor instead.
+func (*ScheduleLine) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ScheduleLine) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *ScheduleLine) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ScheduleLine) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *ScheduleLine) GetFlightNumber() []string {
+	if x != nil {
+		return x.FlightNumber
+	}
+	return nil
+}
+
+func (x *ScheduleLine) GetAircraftReg() string {
+	if x != nil {
+		return x.AircraftReg
+	}
+	return ""
+}
+
+func (x *ScheduleLine) GetPassengerConfig() string {
+	if x != nil {
+		return x.PassengerConfig
+	}
+	return ""
+}
+
+func (x *ScheduleLine) GetIls() string {
+	if x != nil {
+		return x.Ils
+	}
+	return ""
+}
+
+func (x *ScheduleLine) GetWaypoints() []*WayPoint {
+	if x != nil {
+		return x.Waypoints
+	}
+	return nil
+}
+
+func (x *ScheduleLine) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+func (x *ScheduleLine) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type WayPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArrivalTime   string                 `protobuf:"bytes,1,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	ArrivalDate   string                 `protobuf:"bytes,2,opt,name=arrival_date,json=arrivalDate,proto3" json:"arrival_date,omitempty"`
+	ArrivalUtc    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=arrival_utc,json=arrivalUtc,proto3" json:"arrival_utc,omitempty"`
+	Airport       string                 `protobuf:"bytes,4,opt,name=airport,proto3" json:"airport,omitempty"`
+	AirportIcao   string                 `protobuf:"bytes,5,opt,name=airport_icao,json=airportIcao,proto3" json:"airport_icao,omitempty"`
+	DepartureTime string                 `protobuf:"bytes,6,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	DepartureDate string                 `protobuf:"bytes,7,opt,name=departure_date,json=departureDate,proto3" json:"departure_date,omitempty"`
+	DepartureUtc  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=departure_utc,json=departureUtc,proto3" json:"departure_utc,omitempty"`
+}
+
+func (x *WayPoint) Reset() {
+	*x = WayPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WayPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WayPoint) ProtoMessage() {}
+
+func (x *WayPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WayPoint.ProtoReflect.Descriptor instead.
+func (*WayPoint) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WayPoint) GetArrivalTime() string {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return ""
+}
+
+func (x *WayPoint) GetArrivalDate() string {
+	if x != nil {
+		return x.ArrivalDate
+	}
+	return ""
+}
+
+func (x *WayPoint) GetArrivalUtc() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivalUtc
+	}
+	return nil
+}
+
+func (x *WayPoint) GetAirport() string {
+	if x != nil {
+		return x.Airport
+	}
+	return ""
+}
+
+func (x *WayPoint) GetAirportIcao() string {
+	if x != nil {
+		return x.AirportIcao
+	}
+	return ""
+}
+
+func (x *WayPoint) GetDepartureTime() string {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return ""
+}
+
+func (x *WayPoint) GetDepartureDate() string {
+	if x != nil {
+		return x.DepartureDate
+	}
+	return ""
+}
+
+func (x *WayPoint) GetDepartureUtc() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureUtc
+	}
+	return nil
+}
+
+type LineDiagnostic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LineNumber int32  `protobuf:"varint,1,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
+	Status     string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message    string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Text       string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *LineDiagnostic) Reset() {
+	*x = LineDiagnostic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LineDiagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineDiagnostic) ProtoMessage() {}
+
+func (x *LineDiagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineDiagnostic.ProtoReflect.Descriptor instead.
+func (*LineDiagnostic) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LineDiagnostic) GetLineNumber() int32 {
+	if x != nil {
+		return x.LineNumber
+	}
+	return 0
+}
+
+func (x *LineDiagnostic) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LineDiagnostic) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LineDiagnostic) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ScheduleSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     int32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Parsed    int32 `protobuf:"varint,2,opt,name=parsed,proto3" json:"parsed,omitempty"`
+	Cancelled int32 `protobuf:"varint,3,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Failed    int32 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped   int32 `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *ScheduleSummary) Reset() {
+	*x = ScheduleSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_pb_telegram_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSummary) ProtoMessage() {}
+
+func (x *ScheduleSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_pb_telegram_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSummary.ProtoReflect.Descriptor instead.
+func (*ScheduleSummary) Descriptor() ([]byte, []int) {
+	return file_internal_pb_telegram_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ScheduleSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ScheduleSummary) GetParsed() int32 {
+	if x != nil {
+		return x.Parsed
+	}
+	return 0
+}
+
+func (x *ScheduleSummary) GetCancelled() int32 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *ScheduleSummary) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ScheduleSummary) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+var File_internal_pb_telegram_proto protoreflect.FileDescriptor
+
+var file_internal_pb_telegram_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x2f, 0x74, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x63, 0x61,
+	0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x22, 0x22, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0xdb, 0x0a, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x73, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2d, 0x0a, 0x12, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x61, 0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x08, 0x61, 0x69, 0x72, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x08, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x64,
+	0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6e,
+	0x65, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x6e, 0x65, 0x65, 0x64, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61,
+	0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x4f, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x61, 0x61, 0x74, 0x73,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x3f, 0x0a, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61,
+	0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x57, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x03, 0x61, 0x72, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x52, 0x52,
+	0x48, 0x00, 0x52, 0x03, 0x61, 0x72, 0x72, 0x12, 0x22, 0x0a, 0x03, 0x64, 0x65, 0x70, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x45, 0x50, 0x48, 0x00, 0x52, 0x03, 0x64, 0x65, 0x70, 0x12, 0x22, 0x0a, 0x03, 0x63,
+	0x6e, 0x6c, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61, 0x61, 0x74, 0x73,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4e, 0x4c, 0x48, 0x00, 0x52, 0x03, 0x63, 0x6e, 0x6c, 0x12,
+	0x22, 0x0a, 0x03, 0x64, 0x6c, 0x61, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x4c, 0x41, 0x48, 0x00, 0x52, 0x03,
+	0x64, 0x6c, 0x61, 0x12, 0x22, 0x0a, 0x03, 0x63, 0x68, 0x67, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x48, 0x47,
+	0x48, 0x00, 0x52, 0x03, 0x63, 0x68, 0x67, 0x12, 0x22, 0x0a, 0x03, 0x66, 0x70, 0x6c, 0x18, 0x23,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x50, 0x4c, 0x48, 0x00, 0x52, 0x03, 0x66, 0x70, 0x6c, 0x12, 0x22, 0x0a, 0x03, 0x63,
+	0x70, 0x6c, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x61, 0x61, 0x74, 0x73,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x50, 0x4c, 0x48, 0x00, 0x52, 0x03, 0x63, 0x70, 0x6c, 0x12,
+	0x22, 0x0a, 0x03, 0x61, 0x6c, 0x6e, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x4c, 0x4e, 0x48, 0x00, 0x52, 0x03,
+	0x61, 0x6c, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18,
+	0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x08, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x62, 0x6f, 0x64, 0x79, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x51, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xd3, 0x01, 0x0a, 0x07, 0x41, 0x69, 0x72, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x61, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x69, 0x63, 0x61, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x8f, 0x03,
+	0x0a, 0x03, 0x41, 0x52, 0x52, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74,
+	0x49, 0x64, 0x12, 0x29, 0x0a, 0x11, 0x73, 0x73, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
+	0x73, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x69, 0x72,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x72, 0x72, 0x69,
+	0x76, 0x61, 0x6c, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72,
+	0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a,
+	0x16, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6c, 0x61, 0x70, 0x73,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0xe5, 0x02, 0x0a, 0x03, 0x44, 0x45, 0x50, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61,
+	0x66, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x11, 0x73, 0x73, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x73, 0x73, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x69, 0x72,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x45, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61,
+	0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74,
+	0x65, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x74, 0x68, 0x65,
+	0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xbf, 0x01, 0x0a, 0x03, 0x43, 0x4e, 0x4c, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6f, 0x74, 0x68, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xb3, 0x02, 0x0a, 0x03, 0x44, 0x4c,
+	0x41, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x49, 0x64, 0x12, 0x29,
+	0x0a, 0x11, 0x73, 0x73, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x73, 0x72, 0x4d, 0x6f,
+	0x64, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41,
+	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x6e, 0x65, 0x77, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f,
+	0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61,
+	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0xb0, 0x03, 0x0a, 0x03, 0x43, 0x48, 0x47, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61,
+	0x66, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x11, 0x73, 0x73, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x73, 0x73, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x69, 0x72,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x61,
+	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x72,
+	0x72, 0x69, 0x76, 0x61, 0x6c, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x34, 0x0a, 0x16, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6c, 0x61,
+	0x70, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6c, 0x61, 0x70, 0x73, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x74, 0x65, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x41, 0x69, 0x72, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
+	0x72, 0x74, 0x22, 0xb3, 0x07, 0x0a, 0x03, 0x46, 0x50, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66,
+	0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x11, 0x73, 0x73, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x73, 0x73, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x31,
+	0x0a, 0x15, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x37, 0x0a, 0x18, 0x63, 0x72, 0x75, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x15, 0x63, 0x72, 0x75, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65,
+	0x65, 0x64, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x61,
+	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2d, 0x0a,
+	0x12, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x34, 0x0a, 0x16,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61,
+	0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x62, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x70, 0x62, 0x6e, 0x12, 0x31, 0x0a, 0x14, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x71,
+	0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x64, 0x45, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x65, 0x6c, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2f, 0x0a,
+	0x13, 0x72, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x82, 0x04, 0x0a, 0x03, 0x43, 0x50, 0x4c,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a,
+	0x11, 0x73, 0x73, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x73, 0x72, 0x4d, 0x6f, 0x64,
+	0x65, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61,
+	0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x65, 0x71, 0x75, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x69, 0x72,
+	0x63, 0x72, 0x61, 0x66, 0x74, 0x41, 0x6e, 0x64, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x37, 0x0a, 0x18, 0x63, 0x72, 0x75, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x15, 0x63, 0x72, 0x75, 0x69, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65,
+	0x65, 0x64, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x61,
+	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xdf, 0x02,
+	0x0a, 0x03, 0x41, 0x4c, 0x4e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74,
+	0x49, 0x64, 0x12, 0x29, 0x0a, 0x11, 0x73, 0x73, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
+	0x73, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a,
+	0x15, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x69,
+	0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f,
+	0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61,
+	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0xf0, 0x01, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x2d, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x63, 0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12,
+	0x3b, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x34, 0x0a, 0x07,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x63, 0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x22, 0xbe, 0x02, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4c,
+	0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x73,
+	0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61,
+	0x66, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x69,
+	0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x69, 0x6c, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x61, 0x74,
+	0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09,
+	0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x22, 0xd9, 0x02, 0x0a, 0x08, 0x57, 0x61, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61,
+	0x6c, 0x5f, 0x75, 0x74, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x55, 0x74, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x63, 0x61, 0x6f, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x63, 0x61, 0x6f,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3f,
+	0x0a, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x75, 0x74, 0x63, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x55, 0x74, 0x63, 0x22,
+	0x77, 0x0a, 0x0e, 0x4c, 0x69, 0x6e, 0x65, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x32, 0x93, 0x01, 0x0a, 0x0f, 0x54,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44,
+	0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x61,
+	0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x61, 0x61, 0x74, 0x73,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x73, 0x65, 0x12, 0x17, 0x2e,
+	0x63, 0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x42, 0x21, 0x0a, 0x09, 0x63, 0x61, 0x61, 0x74, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a,
+	0x12, 0x63, 0x61, 0x61, 0x74, 0x73, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_pb_telegram_proto_rawDescOnce sync.Once
+	file_internal_pb_telegram_proto_rawDescData = file_internal_pb_telegram_proto_rawDesc
+)
+
+func file_internal_pb_telegram_proto_rawDescGZIP() []byte {
+	file_internal_pb_telegram_proto_rawDescOnce.Do(func() {
+		file_internal_pb_telegram_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_pb_telegram_proto_rawDescData)
+	})
+	return file_internal_pb_telegram_proto_rawDescData
+}
+
+var file_internal_pb_telegram_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_internal_pb_telegram_proto_goTypes = []any{
+	(*SubscribeRequest)(nil),      // 0: caatsm.v1.SubscribeRequest
+	(*ParseRequest)(nil),          // 1: caatsm.v1.ParseRequest
+	(*ParsedMessage)(nil),         // 2: caatsm.v1.ParsedMessage
+	(*HeaderError)(nil),           // 3: caatsm.v1.HeaderError
+	(*Airport)(nil),               // 4: caatsm.v1.Airport
+	(*ARR)(nil),                   // 5: caatsm.v1.ARR
+	(*DEP)(nil),                   // 6: caatsm.v1.DEP
+	(*CNL)(nil),                   // 7: caatsm.v1.CNL
+	(*DLA)(nil),                   // 8: caatsm.v1.DLA
+	(*CHG)(nil),                   // 9: caatsm.v1.CHG
+	(*FPL)(nil),                   // 10: caatsm.v1.FPL
+	(*CPL)(nil),                   // 11: caatsm.v1.CPL
+	(*ALN)(nil),                   // 12: caatsm.v1.ALN
+	(*Schedule)(nil),              // 13: caatsm.v1.Schedule
+	(*ScheduleLine)(nil),          // 14: caatsm.v1.ScheduleLine
+	(*WayPoint)(nil),              // 15: caatsm.v1.WayPoint
+	(*LineDiagnostic)(nil),        // 16: caatsm.v1.LineDiagnostic
+	(*ScheduleSummary)(nil),       // 17: caatsm.v1.ScheduleSummary
+	nil,                           // 18: caatsm.v1.ParsedMessage.TraceContextEntry
+	(*timestamppb.Timestamp)(nil), // 19: google.protobuf.Timestamp
+}
+var file_internal_pb_telegram_proto_depIdxs = []int32{
+	4,  // 0: caatsm.v1.ParsedMessage.airports:type_name -> caatsm.v1.Airport
+	19, // 1: caatsm.v1.ParsedMessage.received_at:type_name -> google.protobuf.Timestamp
+	19, // 2: caatsm.v1.ParsedMessage.parsed_at:type_name -> google.protobuf.Timestamp
+	19, // 3: caatsm.v1.ParsedMessage.dispatched_at:type_name -> google.protobuf.Timestamp
+	3,  // 4: caatsm.v1.ParsedMessage.header_errors:type_name -> caatsm.v1.HeaderError
+	18, // 5: caatsm.v1.ParsedMessage.trace_context:type_name -> caatsm.v1.ParsedMessage.TraceContextEntry
+	3,  // 6: caatsm.v1.ParsedMessage.header_warnings:type_name -> caatsm.v1.HeaderError
+	5,  // 7: caatsm.v1.ParsedMessage.arr:type_name -> caatsm.v1.ARR
+	6,  // 8: caatsm.v1.ParsedMessage.dep:type_name -> caatsm.v1.DEP
+	7,  // 9: caatsm.v1.ParsedMessage.cnl:type_name -> caatsm.v1.CNL
+	8,  // 10: caatsm.v1.ParsedMessage.dla:type_name -> caatsm.v1.DLA
+	9,  // 11: caatsm.v1.ParsedMessage.chg:type_name -> caatsm.v1.CHG
+	10, // 12: caatsm.v1.ParsedMessage.fpl:type_name -> caatsm.v1.FPL
+	11, // 13: caatsm.v1.ParsedMessage.cpl:type_name -> caatsm.v1.CPL
+	12, // 14: caatsm.v1.ParsedMessage.aln:type_name -> caatsm.v1.ALN
+	13, // 15: caatsm.v1.ParsedMessage.schedule:type_name -> caatsm.v1.Schedule
+	14, // 16: caatsm.v1.Schedule.lines:type_name -> caatsm.v1.ScheduleLine
+	16, // 17: caatsm.v1.Schedule.diagnostics:type_name -> caatsm.v1.LineDiagnostic
+	17, // 18: caatsm.v1.Schedule.summary:type_name -> caatsm.v1.ScheduleSummary
+	15, // 19: caatsm.v1.ScheduleLine.waypoints:type_name -> caatsm.v1.WayPoint
+	19, // 20: caatsm.v1.WayPoint.arrival_utc:type_name -> google.protobuf.Timestamp
+	19, // 21: caatsm.v1.WayPoint.departure_utc:type_name -> google.protobuf.Timestamp
+	0,  // 22: caatsm.v1.TelegramService.Subscribe:input_type -> caatsm.v1.SubscribeRequest
+	1,  // 23: caatsm.v1.TelegramService.Parse:input_type -> caatsm.v1.ParseRequest
+	2,  // 24: caatsm.v1.TelegramService.Subscribe:output_type -> caatsm.v1.ParsedMessage
+	2,  // 25: caatsm.v1.TelegramService.Parse:output_type -> caatsm.v1.ParsedMessage
+	24, // [24:26] is the sub-list for method output_type
+	22, // [22:24] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_internal_pb_telegram_proto_init() }
+func file_internal_pb_telegram_proto_init() {
+	if File_internal_pb_telegram_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_pb_telegram_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ParseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ParsedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*HeaderError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Airport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ARR); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DEP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CNL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*DLA); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*CHG); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*FPL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*CPL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ALN); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*Schedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ScheduleLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*WayPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*LineDiagnostic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_pb_telegram_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ScheduleSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_pb_telegram_proto_msgTypes[2].OneofWrappers = []any{
+		(*ParsedMessage_Arr)(nil),
+		(*ParsedMessage_Dep)(nil),
+		(*ParsedMessage_Cnl)(nil),
+		(*ParsedMessage_Dla)(nil),
+		(*ParsedMessage_Chg)(nil),
+		(*ParsedMessage_Fpl)(nil),
+		(*ParsedMessage_Cpl)(nil),
+		(*ParsedMessage_Aln)(nil),
+		(*ParsedMessage_Schedule)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_pb_telegram_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_pb_telegram_proto_goTypes,
+		DependencyIndexes: file_internal_pb_telegram_proto_depIdxs,
+		MessageInfos:      file_internal_pb_telegram_proto_msgTypes,
+	}.Build()
+	File_internal_pb_telegram_proto = out.File
+	file_internal_pb_telegram_proto_rawDesc = nil
+	file_internal_pb_telegram_proto_goTypes = nil
+	file_internal_pb_telegram_proto_depIdxs = nil
+}
@@ -0,0 +1,193 @@
+// Protobuf form of the published messages, an alternative to their JSON
+// encoding. Field names follow the JSON keys of the domain types.
+//
+// Regenerate telegram.pb.go and telegram_grpc.pb.go with 'task proto'.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: internal/pb/telegram.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	TelegramService_Subscribe_FullMethodName = "/caatsm.v1.TelegramService/Subscribe"
+	TelegramService_Parse_FullMethodName     = "/caatsm.v1.TelegramService/Parse"
+)
+
+// TelegramServiceClient is the client API for TelegramService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TelegramService streams the telegrams parsed by the listener and parses
+// telegrams on demand
+type TelegramServiceClient interface {
+	// Subscribe streams the telegrams parsed from now on that match the request.
+	// Telegrams are dropped for a client that cannot keep up.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (TelegramService_SubscribeClient, error)
+	// Parse parses a raw telegram without saving or publishing it
+	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParsedMessage, error)
+}
+
+type telegramServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTelegramServiceClient(cc grpc.ClientConnInterface) TelegramServiceClient {
+	return &telegramServiceClient{cc}
+}
+
+func (c *telegramServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (TelegramService_SubscribeClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TelegramService_ServiceDesc.Streams[0], TelegramService_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &telegramServiceSubscribeClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TelegramService_SubscribeClient interface {
+	Recv() (*ParsedMessage, error)
+	grpc.ClientStream
+}
+
+type telegramServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *telegramServiceSubscribeClient) Recv() (*ParsedMessage, error) {
+	m := new(ParsedMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *telegramServiceClient) Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParsedMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParsedMessage)
+	err := c.cc.Invoke(ctx, TelegramService_Parse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TelegramServiceServer is the server API for TelegramService service.
+// All implementations must embed UnimplementedTelegramServiceServer
+// for forward compatibility
+//
+// TelegramService streams the telegrams parsed by the listener and parses
+// telegrams on demand
+type TelegramServiceServer interface {
+	// Subscribe streams the telegrams parsed from now on that match the request.
+	// Telegrams are dropped for a client that cannot keep up.
+	Subscribe(*SubscribeRequest, TelegramService_SubscribeServer) error
+	// Parse parses a raw telegram without saving or publishing it
+	Parse(context.Context, *ParseRequest) (*ParsedMessage, error)
+	mustEmbedUnimplementedTelegramServiceServer()
+}
+
+// UnimplementedTelegramServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTelegramServiceServer struct {
+}
+
+func (UnimplementedTelegramServiceServer) Subscribe(*SubscribeRequest, TelegramService_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedTelegramServiceServer) Parse(context.Context, *ParseRequest) (*ParsedMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Parse not implemented")
+}
+func (UnimplementedTelegramServiceServer) mustEmbedUnimplementedTelegramServiceServer() {}
+
+// UnsafeTelegramServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TelegramServiceServer will
+// result in compilation errors.
+type UnsafeTelegramServiceServer interface {
+	mustEmbedUnimplementedTelegramServiceServer()
+}
+
+func RegisterTelegramServiceServer(s grpc.ServiceRegistrar, srv TelegramServiceServer) {
+	s.RegisterService(&TelegramService_ServiceDesc, srv)
+}
+
+func _TelegramService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TelegramServiceServer).Subscribe(m, &telegramServiceSubscribeServer{ServerStream: stream})
+}
+
+type TelegramService_SubscribeServer interface {
+	Send(*ParsedMessage) error
+	grpc.ServerStream
+}
+
+type telegramServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *telegramServiceSubscribeServer) Send(m *ParsedMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TelegramService_Parse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelegramServiceServer).Parse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TelegramService_Parse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelegramServiceServer).Parse(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TelegramService_ServiceDesc is the grpc.ServiceDesc for TelegramService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TelegramService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "caatsm.v1.TelegramService",
+	HandlerType: (*TelegramServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Parse",
+			Handler:    _TelegramService_Parse_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _TelegramService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "internal/pb/telegram.proto",
+}
@@ -11,6 +11,110 @@ import (
 	"github.com/google/uuid"
 )
 
+// input type for inserting data into table "aviation.outbox"
+type Aviation_outbox_insert_input struct {
+	Attempts      int             `json:"attempts"`
+	Claimed_at    time.Time       `json:"claimed_at"`
+	Claimed_by    string          `json:"claimed_by"`
+	Created_at    time.Time       `json:"created_at"`
+	Failed_at     time.Time       `json:"failed_at"`
+	Headers       json.RawMessage `json:"headers"`
+	Last_error    string          `json:"last_error"`
+	Payload       json.RawMessage `json:"payload"`
+	Sent_at       *time.Time      `json:"sent_at,omitempty"`
+	Telegram_uuid uuid.UUID       `json:"telegram_uuid"`
+	Topic         string          `json:"topic"`
+	Trace_context json.RawMessage `json:"trace_context"`
+	Uuid          uuid.UUID       `json:"uuid"`
+}
+
+// GetAttempts returns Aviation_outbox_insert_input.Attempts, and is useful for accessing the field via an interface.
+func (v *Aviation_outbox_insert_input) GetAttempts() int { return v.Attempts }
+
+// GetClaimed_at returns Aviation_outbox_insert_input.Claimed_at, and is useful for accessing the field via an interface.
+func (v *Aviation_outbox_insert_input) GetClaimed_at() time.Time { return v.Claimed_at }
+
+// GetClaimed_by returns Aviation_outbox_insert_input.Claimed_by, and is useful for accessing the field via an interface.
+func (v *Aviation_outbox_insert_input) GetClaimed_by() string { return v.Claimed_by }
+
+// GetCreated_at returns Aviation_outbox_insert_input.Created_at, and is useful for accessing the field via an interface.
+func (v *Aviation_outbox_insert_input) GetCreated_at() time.Time { return v.Created_at }
+
+// GetFailed_at returns Aviation_outbox_insert_input.Failed_at, and is useful for accessing the field via an interface.
+func (v *Aviation_outbox_insert_input) GetFailed_at() time.Time { return v.Failed_at }
+
+// GetHeaders returns Aviation_outbox_insert_input.Headers, and is useful for accessing the field via an interface.
+func (v *Aviation_outbox_insert_input) GetHeaders() json.RawMessage { return v.Headers }
+
+// GetLast_error returns Aviation_outbox_insert_input.Last_error, and is useful for accessing the field via an interface.
+func (v *Aviation_outbox_insert_input) GetLast_error() string { return v.Last_error }
+
+// GetPayload returns Aviation_outbox_insert_input.Payload, and is useful for accessing the field via an interface.
+func (v *Aviation_outbox_insert_input) GetPayload() json.RawMessage { return v.Payload }
+
+// GetSent_at returns Aviation_outbox_insert_input.Sent_at, and is useful for accessing the field via an interface.
+func (v *Aviation_outbox_insert_input) GetSent_at() *time.Time { return v.Sent_at }
+
+// GetTelegram_uuid returns Aviation_outbox_insert_input.Telegram_uuid, and is useful for accessing the field via an interface.
+func (v *Aviation_outbox_insert_input) GetTelegram_uuid() uuid.UUID { return v.Telegram_uuid }
+
+// GetTopic returns Aviation_outbox_insert_input.Topic, and is useful for accessing the field via an interface.
+func (v *Aviation_outbox_insert_input) GetTopic() string { return v.Topic }
+
+// GetTrace_context returns Aviation_outbox_insert_input.Trace_context, and is useful for accessing the field via an interface.
+func (v *Aviation_outbox_insert_input) GetTrace_context() json.RawMessage { return v.Trace_context }
+
+// GetUuid returns Aviation_outbox_insert_input.Uuid, and is useful for accessing the field via an interface.
+func (v *Aviation_outbox_insert_input) GetUuid() uuid.UUID { return v.Uuid }
+
+// input type for inserting data into table "aviation.schedule_lines"
+type Aviation_schedule_lines_insert_input struct {
+	Aircraft_reg  string          `json:"aircraft_reg"`
+	Airline       string          `json:"airline"`
+	Comments      string          `json:"comments"`
+	Created_at    time.Time       `json:"created_at"`
+	Date          string          `json:"date"`
+	Flight_number string          `json:"flight_number"`
+	Idx           string          `json:"idx"`
+	Task          string          `json:"task"`
+	Telegram_uuid uuid.UUID       `json:"telegram_uuid"`
+	Uuid          uuid.UUID       `json:"uuid"`
+	Waypoints     json.RawMessage `json:"waypoints"`
+}
+
+// GetAircraft_reg returns Aviation_schedule_lines_insert_input.Aircraft_reg, and is useful for accessing the field via an interface.
+func (v *Aviation_schedule_lines_insert_input) GetAircraft_reg() string { return v.Aircraft_reg }
+
+// GetAirline returns Aviation_schedule_lines_insert_input.Airline, and is useful for accessing the field via an interface.
+func (v *Aviation_schedule_lines_insert_input) GetAirline() string { return v.Airline }
+
+// GetComments returns Aviation_schedule_lines_insert_input.Comments, and is useful for accessing the field via an interface.
+func (v *Aviation_schedule_lines_insert_input) GetComments() string { return v.Comments }
+
+// GetCreated_at returns Aviation_schedule_lines_insert_input.Created_at, and is useful for accessing the field via an interface.
+func (v *Aviation_schedule_lines_insert_input) GetCreated_at() time.Time { return v.Created_at }
+
+// GetDate returns Aviation_schedule_lines_insert_input.Date, and is useful for accessing the field via an interface.
+func (v *Aviation_schedule_lines_insert_input) GetDate() string { return v.Date }
+
+// GetFlight_number returns Aviation_schedule_lines_insert_input.Flight_number, and is useful for accessing the field via an interface.
+func (v *Aviation_schedule_lines_insert_input) GetFlight_number() string { return v.Flight_number }
+
+// GetIdx returns Aviation_schedule_lines_insert_input.Idx, and is useful for accessing the field via an interface.
+func (v *Aviation_schedule_lines_insert_input) GetIdx() string { return v.Idx }
+
+// GetTask returns Aviation_schedule_lines_insert_input.Task, and is useful for accessing the field via an interface.
+func (v *Aviation_schedule_lines_insert_input) GetTask() string { return v.Task }
+
+// GetTelegram_uuid returns Aviation_schedule_lines_insert_input.Telegram_uuid, and is useful for accessing the field via an interface.
+func (v *Aviation_schedule_lines_insert_input) GetTelegram_uuid() uuid.UUID { return v.Telegram_uuid }
+
+// GetUuid returns Aviation_schedule_lines_insert_input.Uuid, and is useful for accessing the field via an interface.
+func (v *Aviation_schedule_lines_insert_input) GetUuid() uuid.UUID { return v.Uuid }
+
+// GetWaypoints returns Aviation_schedule_lines_insert_input.Waypoints, and is useful for accessing the field via an interface.
+func (v *Aviation_schedule_lines_insert_input) GetWaypoints() json.RawMessage { return v.Waypoints }
+
 // input type for inserting data into table "aviation.telegrams"
 type Aviation_telegrams_insert_input struct {
 	Body_data            json.RawMessage `json:"body_data"`
@@ -79,6 +183,58 @@ func (v *Aviation_telegrams_insert_input) GetSecondary_addresses() string {
 // GetUuid returns Aviation_telegrams_insert_input.Uuid, and is useful for accessing the field via an interface.
 func (v *Aviation_telegrams_insert_input) GetUuid() uuid.UUID { return v.Uuid }
 
+// __claimOutboxInput is used internally by genqlient
+type __claimOutboxInput struct {
+	Uuids      []uuid.UUID `json:"uuids"`
+	Expired    time.Time   `json:"expired"`
+	Claimed_by string      `json:"claimed_by"`
+	Claimed_at time.Time   `json:"claimed_at"`
+}
+
+// GetUuids returns __claimOutboxInput.Uuids, and is useful for accessing the field via an interface.
+func (v *__claimOutboxInput) GetUuids() []uuid.UUID { return v.Uuids }
+
+// GetExpired returns __claimOutboxInput.Expired, and is useful for accessing the field via an interface.
+func (v *__claimOutboxInput) GetExpired() time.Time { return v.Expired }
+
+// GetClaimed_by returns __claimOutboxInput.Claimed_by, and is useful for accessing the field via an interface.
+func (v *__claimOutboxInput) GetClaimed_by() string { return v.Claimed_by }
+
+// GetClaimed_at returns __claimOutboxInput.Claimed_at, and is useful for accessing the field via an interface.
+func (v *__claimOutboxInput) GetClaimed_at() time.Time { return v.Claimed_at }
+
+// __failOutboxInput is used internally by genqlient
+type __failOutboxInput struct {
+	Uuid       uuid.UUID `json:"uuid"`
+	Attempts   int       `json:"attempts"`
+	Last_error string    `json:"last_error"`
+	Failed_at  time.Time `json:"failed_at"`
+}
+
+// GetUuid returns __failOutboxInput.Uuid, and is useful for accessing the field via an interface.
+func (v *__failOutboxInput) GetUuid() uuid.UUID { return v.Uuid }
+
+// GetAttempts returns __failOutboxInput.Attempts, and is useful for accessing the field via an interface.
+func (v *__failOutboxInput) GetAttempts() int { return v.Attempts }
+
+// GetLast_error returns __failOutboxInput.Last_error, and is useful for accessing the field via an interface.
+func (v *__failOutboxInput) GetLast_error() string { return v.Last_error }
+
+// GetFailed_at returns __failOutboxInput.Failed_at, and is useful for accessing the field via an interface.
+func (v *__failOutboxInput) GetFailed_at() time.Time { return v.Failed_at }
+
+// __markOutboxSentInput is used internally by genqlient
+type __markOutboxSentInput struct {
+	Uuids   []uuid.UUID `json:"uuids"`
+	Sent_at time.Time   `json:"sent_at"`
+}
+
+// GetUuids returns __markOutboxSentInput.Uuids, and is useful for accessing the field via an interface.
+func (v *__markOutboxSentInput) GetUuids() []uuid.UUID { return v.Uuids }
+
+// GetSent_at returns __markOutboxSentInput.Sent_at, and is useful for accessing the field via an interface.
+func (v *__markOutboxSentInput) GetSent_at() time.Time { return v.Sent_at }
+
 // __newMessageInput is used internally by genqlient
 type __newMessageInput struct {
 	Object Aviation_telegrams_insert_input `json:"object"`
@@ -87,6 +243,194 @@ type __newMessageInput struct {
 // GetObject returns __newMessageInput.Object, and is useful for accessing the field via an interface.
 func (v *__newMessageInput) GetObject() Aviation_telegrams_insert_input { return v.Object }
 
+// __newMessageWithOutboxInput is used internally by genqlient
+type __newMessageWithOutboxInput struct {
+	Object Aviation_telegrams_insert_input        `json:"object"`
+	Lines  []Aviation_schedule_lines_insert_input `json:"lines"`
+	Outbox []Aviation_outbox_insert_input         `json:"outbox"`
+}
+
+// GetObject returns __newMessageWithOutboxInput.Object, and is useful for accessing the field via an interface.
+func (v *__newMessageWithOutboxInput) GetObject() Aviation_telegrams_insert_input { return v.Object }
+
+// GetLines returns __newMessageWithOutboxInput.Lines, and is useful for accessing the field via an interface.
+func (v *__newMessageWithOutboxInput) GetLines() []Aviation_schedule_lines_insert_input {
+	return v.Lines
+}
+
+// GetOutbox returns __newMessageWithOutboxInput.Outbox, and is useful for accessing the field via an interface.
+func (v *__newMessageWithOutboxInput) GetOutbox() []Aviation_outbox_insert_input { return v.Outbox }
+
+// __outboxCandidatesInput is used internally by genqlient
+type __outboxCandidatesInput struct {
+	Limit   int       `json:"limit"`
+	Expired time.Time `json:"expired"`
+}
+
+// GetLimit returns __outboxCandidatesInput.Limit, and is useful for accessing the field via an interface.
+func (v *__outboxCandidatesInput) GetLimit() int { return v.Limit }
+
+// GetExpired returns __outboxCandidatesInput.Expired, and is useful for accessing the field via an interface.
+func (v *__outboxCandidatesInput) GetExpired() time.Time { return v.Expired }
+
+// __releaseOutboxInput is used internally by genqlient
+type __releaseOutboxInput struct {
+	Uuids      []uuid.UUID `json:"uuids"`
+	Claimed_by string      `json:"claimed_by"`
+}
+
+// GetUuids returns __releaseOutboxInput.Uuids, and is useful for accessing the field via an interface.
+func (v *__releaseOutboxInput) GetUuids() []uuid.UUID { return v.Uuids }
+
+// GetClaimed_by returns __releaseOutboxInput.Claimed_by, and is useful for accessing the field via an interface.
+func (v *__releaseOutboxInput) GetClaimed_by() string { return v.Claimed_by }
+
+// __retryOutboxInput is used internally by genqlient
+type __retryOutboxInput struct {
+	Uuid       uuid.UUID `json:"uuid"`
+	Attempts   int       `json:"attempts"`
+	Last_error string    `json:"last_error"`
+}
+
+// GetUuid returns __retryOutboxInput.Uuid, and is useful for accessing the field via an interface.
+func (v *__retryOutboxInput) GetUuid() uuid.UUID { return v.Uuid }
+
+// GetAttempts returns __retryOutboxInput.Attempts, and is useful for accessing the field via an interface.
+func (v *__retryOutboxInput) GetAttempts() int { return v.Attempts }
+
+// GetLast_error returns __retryOutboxInput.Last_error, and is useful for accessing the field via an interface.
+func (v *__retryOutboxInput) GetLast_error() string { return v.Last_error }
+
+// claimOutboxResponse is returned by claimOutbox on success.
+type claimOutboxResponse struct {
+	// update data of the table: "aviation.outbox"
+	Update_aviation_outbox claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_response `json:"update_aviation_outbox"`
+}
+
+// GetUpdate_aviation_outbox returns claimOutboxResponse.Update_aviation_outbox, and is useful for accessing the field via an interface.
+func (v *claimOutboxResponse) GetUpdate_aviation_outbox() claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_response {
+	return v.Update_aviation_outbox
+}
+
+// claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_response includes the requested fields of the GraphQL type aviation_outbox_mutation_response.
+// The GraphQL type's documentation follows.
+//
+// response of any mutation on the table "aviation.outbox"
+type claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_response struct {
+	// data from the rows affected by the mutation
+	Returning []claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox `json:"returning"`
+}
+
+// GetReturning returns claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_response.Returning, and is useful for accessing the field via an interface.
+func (v *claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_response) GetReturning() []claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox {
+	return v.Returning
+}
+
+// claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox includes the requested fields of the GraphQL type aviation_outbox.
+// The GraphQL type's documentation follows.
+//
+// columns and relationships of "aviation.outbox"
+type claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox struct {
+	Uuid          uuid.UUID       `json:"uuid"`
+	Telegram_uuid uuid.UUID       `json:"telegram_uuid"`
+	Topic         string          `json:"topic"`
+	Payload       json.RawMessage `json:"payload"`
+	Headers       json.RawMessage `json:"headers"`
+	Trace_context json.RawMessage `json:"trace_context"`
+	Attempts      int             `json:"attempts"`
+	Created_at    time.Time       `json:"created_at"`
+}
+
+// GetUuid returns claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox.Uuid, and is useful for accessing the field via an interface.
+func (v *claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox) GetUuid() uuid.UUID {
+	return v.Uuid
+}
+
+// GetTelegram_uuid returns claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox.Telegram_uuid, and is useful for accessing the field via an interface.
+func (v *claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox) GetTelegram_uuid() uuid.UUID {
+	return v.Telegram_uuid
+}
+
+// GetTopic returns claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox.Topic, and is useful for accessing the field via an interface.
+func (v *claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox) GetTopic() string {
+	return v.Topic
+}
+
+// GetPayload returns claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox.Payload, and is useful for accessing the field via an interface.
+func (v *claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox) GetPayload() json.RawMessage {
+	return v.Payload
+}
+
+// GetHeaders returns claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox.Headers, and is useful for accessing the field via an interface.
+func (v *claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox) GetHeaders() json.RawMessage {
+	return v.Headers
+}
+
+// GetTrace_context returns claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox.Trace_context, and is useful for accessing the field via an interface.
+func (v *claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox) GetTrace_context() json.RawMessage {
+	return v.Trace_context
+}
+
+// GetAttempts returns claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox.Attempts, and is useful for accessing the field via an interface.
+func (v *claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox) GetAttempts() int {
+	return v.Attempts
+}
+
+// GetCreated_at returns claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox.Created_at, and is useful for accessing the field via an interface.
+func (v *claimOutboxUpdate_aviation_outboxAviation_outbox_mutation_responseReturningAviation_outbox) GetCreated_at() time.Time {
+	return v.Created_at
+}
+
+// failOutboxResponse is returned by failOutbox on success.
+type failOutboxResponse struct {
+	// update data of the table: "aviation.outbox"
+	Update_aviation_outbox failOutboxUpdate_aviation_outboxAviation_outbox_mutation_response `json:"update_aviation_outbox"`
+}
+
+// GetUpdate_aviation_outbox returns failOutboxResponse.Update_aviation_outbox, and is useful for accessing the field via an interface.
+func (v *failOutboxResponse) GetUpdate_aviation_outbox() failOutboxUpdate_aviation_outboxAviation_outbox_mutation_response {
+	return v.Update_aviation_outbox
+}
+
+// failOutboxUpdate_aviation_outboxAviation_outbox_mutation_response includes the requested fields of the GraphQL type aviation_outbox_mutation_response.
+// The GraphQL type's documentation follows.
+//
+// response of any mutation on the table "aviation.outbox"
+type failOutboxUpdate_aviation_outboxAviation_outbox_mutation_response struct {
+	// number of rows affected by the mutation
+	Affected_rows int `json:"affected_rows"`
+}
+
+// GetAffected_rows returns failOutboxUpdate_aviation_outboxAviation_outbox_mutation_response.Affected_rows, and is useful for accessing the field via an interface.
+func (v *failOutboxUpdate_aviation_outboxAviation_outbox_mutation_response) GetAffected_rows() int {
+	return v.Affected_rows
+}
+
+// markOutboxSentResponse is returned by markOutboxSent on success.
+type markOutboxSentResponse struct {
+	// update data of the table: "aviation.outbox"
+	Update_aviation_outbox markOutboxSentUpdate_aviation_outboxAviation_outbox_mutation_response `json:"update_aviation_outbox"`
+}
+
+// GetUpdate_aviation_outbox returns markOutboxSentResponse.Update_aviation_outbox, and is useful for accessing the field via an interface.
+func (v *markOutboxSentResponse) GetUpdate_aviation_outbox() markOutboxSentUpdate_aviation_outboxAviation_outbox_mutation_response {
+	return v.Update_aviation_outbox
+}
+
+// markOutboxSentUpdate_aviation_outboxAviation_outbox_mutation_response includes the requested fields of the GraphQL type aviation_outbox_mutation_response.
+// The GraphQL type's documentation follows.
+//
+// response of any mutation on the table "aviation.outbox"
+type markOutboxSentUpdate_aviation_outboxAviation_outbox_mutation_response struct {
+	// number of rows affected by the mutation
+	Affected_rows int `json:"affected_rows"`
+}
+
+// GetAffected_rows returns markOutboxSentUpdate_aviation_outboxAviation_outbox_mutation_response.Affected_rows, and is useful for accessing the field via an interface.
+func (v *markOutboxSentUpdate_aviation_outboxAviation_outbox_mutation_response) GetAffected_rows() int {
+	return v.Affected_rows
+}
+
 // newMessageInsert_aviation_telegrams_oneAviation_telegrams includes the requested fields of the GraphQL type aviation_telegrams.
 // The GraphQL type's documentation follows.
 //
@@ -117,16 +461,301 @@ func (v *newMessageResponse) GetInsert_aviation_telegrams_one() newMessageInsert
 	return v.Insert_aviation_telegrams_one
 }
 
+// newMessageWithOutboxInsert_aviation_outboxAviation_outbox_mutation_response includes the requested fields of the GraphQL type aviation_outbox_mutation_response.
+// The GraphQL type's documentation follows.
+//
+// response of any mutation on the table "aviation.outbox"
+type newMessageWithOutboxInsert_aviation_outboxAviation_outbox_mutation_response struct {
+	// number of rows affected by the mutation
+	Affected_rows int `json:"affected_rows"`
+}
+
+// GetAffected_rows returns newMessageWithOutboxInsert_aviation_outboxAviation_outbox_mutation_response.Affected_rows, and is useful for accessing the field via an interface.
+func (v *newMessageWithOutboxInsert_aviation_outboxAviation_outbox_mutation_response) GetAffected_rows() int {
+	return v.Affected_rows
+}
+
+// newMessageWithOutboxInsert_aviation_schedule_linesAviation_schedule_lines_mutation_response includes the requested fields of the GraphQL type aviation_schedule_lines_mutation_response.
+// The GraphQL type's documentation follows.
+//
+// response of any mutation on the table "aviation.schedule_lines"
+type newMessageWithOutboxInsert_aviation_schedule_linesAviation_schedule_lines_mutation_response struct {
+	// number of rows affected by the mutation
+	Affected_rows int `json:"affected_rows"`
+}
+
+// GetAffected_rows returns newMessageWithOutboxInsert_aviation_schedule_linesAviation_schedule_lines_mutation_response.Affected_rows, and is useful for accessing the field via an interface.
+func (v *newMessageWithOutboxInsert_aviation_schedule_linesAviation_schedule_lines_mutation_response) GetAffected_rows() int {
+	return v.Affected_rows
+}
+
+// newMessageWithOutboxInsert_aviation_telegrams_oneAviation_telegrams includes the requested fields of the GraphQL type aviation_telegrams.
+// The GraphQL type's documentation follows.
+//
+// columns and relationships of "aviation.telegrams"
+type newMessageWithOutboxInsert_aviation_telegrams_oneAviation_telegrams struct {
+	Message_id string    `json:"message_id"`
+	Uuid       uuid.UUID `json:"uuid"`
+}
+
+// GetMessage_id returns newMessageWithOutboxInsert_aviation_telegrams_oneAviation_telegrams.Message_id, and is useful for accessing the field via an interface.
+func (v *newMessageWithOutboxInsert_aviation_telegrams_oneAviation_telegrams) GetMessage_id() string {
+	return v.Message_id
+}
+
+// GetUuid returns newMessageWithOutboxInsert_aviation_telegrams_oneAviation_telegrams.Uuid, and is useful for accessing the field via an interface.
+func (v *newMessageWithOutboxInsert_aviation_telegrams_oneAviation_telegrams) GetUuid() uuid.UUID {
+	return v.Uuid
+}
+
+// newMessageWithOutboxResponse is returned by newMessageWithOutbox on success.
+type newMessageWithOutboxResponse struct {
+	// insert a single row into the table: "aviation.telegrams"
+	Insert_aviation_telegrams_one newMessageWithOutboxInsert_aviation_telegrams_oneAviation_telegrams `json:"insert_aviation_telegrams_one"`
+	// insert data into the table: "aviation.schedule_lines"
+	Insert_aviation_schedule_lines newMessageWithOutboxInsert_aviation_schedule_linesAviation_schedule_lines_mutation_response `json:"insert_aviation_schedule_lines"`
+	// insert data into the table: "aviation.outbox"
+	Insert_aviation_outbox newMessageWithOutboxInsert_aviation_outboxAviation_outbox_mutation_response `json:"insert_aviation_outbox"`
+}
+
+// GetInsert_aviation_telegrams_one returns newMessageWithOutboxResponse.Insert_aviation_telegrams_one, and is useful for accessing the field via an interface.
+func (v *newMessageWithOutboxResponse) GetInsert_aviation_telegrams_one() newMessageWithOutboxInsert_aviation_telegrams_oneAviation_telegrams {
+	return v.Insert_aviation_telegrams_one
+}
+
+// GetInsert_aviation_schedule_lines returns newMessageWithOutboxResponse.Insert_aviation_schedule_lines, and is useful for accessing the field via an interface.
+func (v *newMessageWithOutboxResponse) GetInsert_aviation_schedule_lines() newMessageWithOutboxInsert_aviation_schedule_linesAviation_schedule_lines_mutation_response {
+	return v.Insert_aviation_schedule_lines
+}
+
+// GetInsert_aviation_outbox returns newMessageWithOutboxResponse.Insert_aviation_outbox, and is useful for accessing the field via an interface.
+func (v *newMessageWithOutboxResponse) GetInsert_aviation_outbox() newMessageWithOutboxInsert_aviation_outboxAviation_outbox_mutation_response {
+	return v.Insert_aviation_outbox
+}
+
+// outboxCandidatesAviation_outbox includes the requested fields of the GraphQL type aviation_outbox.
+// The GraphQL type's documentation follows.
+//
+// columns and relationships of "aviation.outbox"
+type outboxCandidatesAviation_outbox struct {
+	Uuid uuid.UUID `json:"uuid"`
+}
+
+// GetUuid returns outboxCandidatesAviation_outbox.Uuid, and is useful for accessing the field via an interface.
+func (v *outboxCandidatesAviation_outbox) GetUuid() uuid.UUID { return v.Uuid }
+
+// outboxCandidatesResponse is returned by outboxCandidates on success.
+type outboxCandidatesResponse struct {
+	// fetch data from the table: "aviation.outbox"
+	Aviation_outbox []outboxCandidatesAviation_outbox `json:"aviation_outbox"`
+}
+
+// GetAviation_outbox returns outboxCandidatesResponse.Aviation_outbox, and is useful for accessing the field via an interface.
+func (v *outboxCandidatesResponse) GetAviation_outbox() []outboxCandidatesAviation_outbox {
+	return v.Aviation_outbox
+}
+
+// pingResponse is returned by ping on success.
+type pingResponse struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns pingResponse.Typename, and is useful for accessing the field via an interface.
+func (v *pingResponse) GetTypename() string { return v.Typename }
+
+// releaseOutboxResponse is returned by releaseOutbox on success.
+type releaseOutboxResponse struct {
+	// update data of the table: "aviation.outbox"
+	Update_aviation_outbox releaseOutboxUpdate_aviation_outboxAviation_outbox_mutation_response `json:"update_aviation_outbox"`
+}
+
+// GetUpdate_aviation_outbox returns releaseOutboxResponse.Update_aviation_outbox, and is useful for accessing the field via an interface.
+func (v *releaseOutboxResponse) GetUpdate_aviation_outbox() releaseOutboxUpdate_aviation_outboxAviation_outbox_mutation_response {
+	return v.Update_aviation_outbox
+}
+
+// releaseOutboxUpdate_aviation_outboxAviation_outbox_mutation_response includes the requested fields of the GraphQL type aviation_outbox_mutation_response.
+// The GraphQL type's documentation follows.
+//
+// response of any mutation on the table "aviation.outbox"
+type releaseOutboxUpdate_aviation_outboxAviation_outbox_mutation_response struct {
+	// number of rows affected by the mutation
+	Affected_rows int `json:"affected_rows"`
+}
+
+// GetAffected_rows returns releaseOutboxUpdate_aviation_outboxAviation_outbox_mutation_response.Affected_rows, and is useful for accessing the field via an interface.
+func (v *releaseOutboxUpdate_aviation_outboxAviation_outbox_mutation_response) GetAffected_rows() int {
+	return v.Affected_rows
+}
+
+// retryOutboxResponse is returned by retryOutbox on success.
+type retryOutboxResponse struct {
+	// update data of the table: "aviation.outbox"
+	Update_aviation_outbox retryOutboxUpdate_aviation_outboxAviation_outbox_mutation_response `json:"update_aviation_outbox"`
+}
+
+// GetUpdate_aviation_outbox returns retryOutboxResponse.Update_aviation_outbox, and is useful for accessing the field via an interface.
+func (v *retryOutboxResponse) GetUpdate_aviation_outbox() retryOutboxUpdate_aviation_outboxAviation_outbox_mutation_response {
+	return v.Update_aviation_outbox
+}
+
+// retryOutboxUpdate_aviation_outboxAviation_outbox_mutation_response includes the requested fields of the GraphQL type aviation_outbox_mutation_response.
+// The GraphQL type's documentation follows.
+//
+// response of any mutation on the table "aviation.outbox"
+type retryOutboxUpdate_aviation_outboxAviation_outbox_mutation_response struct {
+	// number of rows affected by the mutation
+	Affected_rows int `json:"affected_rows"`
+}
+
+// GetAffected_rows returns retryOutboxUpdate_aviation_outboxAviation_outbox_mutation_response.Affected_rows, and is useful for accessing the field via an interface.
+func (v *retryOutboxUpdate_aviation_outboxAviation_outbox_mutation_response) GetAffected_rows() int {
+	return v.Affected_rows
+}
+
+// The query or mutation executed by claimOutbox.
+const claimOutbox_Operation = `
+mutation claimOutbox ($uuids: [uuid!]!, $expired: timestamp!, $claimed_by: String!, $claimed_at: timestamp!) {
+	update_aviation_outbox(where: {uuid:{_in:$uuids},sent_at:{_is_null:true},failed_at:{_is_null:true},_or:[{claimed_at:{_is_null:true}},{claimed_at:{_lt:$expired}}]}, _set: {claimed_by:$claimed_by,claimed_at:$claimed_at}) {
+		returning {
+			uuid
+			telegram_uuid
+			topic
+			payload
+			headers
+			trace_context
+			attempts
+			created_at
+		}
+	}
+}
+`
+
+// claimOutbox claims the candidates still unclaimed, and returns those it got:
+// the condition is checked again by the update, so a record goes to one relay
+func claimOutbox(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	uuids []uuid.UUID,
+	expired time.Time,
+	claimed_by string,
+	claimed_at time.Time,
+) (*claimOutboxResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "claimOutbox",
+		Query:  claimOutbox_Operation,
+		Variables: &__claimOutboxInput{
+			Uuids:      uuids,
+			Expired:    expired,
+			Claimed_by: claimed_by,
+			Claimed_at: claimed_at,
+		},
+	}
+	var err_ error
+
+	var data_ claimOutboxResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by failOutbox.
+const failOutbox_Operation = `
+mutation failOutbox ($uuid: uuid!, $attempts: Int!, $last_error: String!, $failed_at: timestamp!) {
+	update_aviation_outbox(where: {uuid:{_eq:$uuid}}, _set: {attempts:$attempts,last_error:$last_error,failed_at:$failed_at}) {
+		affected_rows
+	}
+}
+`
+
+// failOutbox records the last attempt of a record sent to the dead-letter topic
+func failOutbox(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	uuid uuid.UUID,
+	attempts int,
+	last_error string,
+	failed_at time.Time,
+) (*failOutboxResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "failOutbox",
+		Query:  failOutbox_Operation,
+		Variables: &__failOutboxInput{
+			Uuid:       uuid,
+			Attempts:   attempts,
+			Last_error: last_error,
+			Failed_at:  failed_at,
+		},
+	}
+	var err_ error
+
+	var data_ failOutboxResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by markOutboxSent.
+const markOutboxSent_Operation = `
+mutation markOutboxSent ($uuids: [uuid!]!, $sent_at: timestamp!) {
+	update_aviation_outbox(where: {uuid:{_in:$uuids}}, _set: {sent_at:$sent_at}) {
+		affected_rows
+	}
+}
+`
+
+func markOutboxSent(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	uuids []uuid.UUID,
+	sent_at time.Time,
+) (*markOutboxSentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "markOutboxSent",
+		Query:  markOutboxSent_Operation,
+		Variables: &__markOutboxSentInput{
+			Uuids:   uuids,
+			Sent_at: sent_at,
+		},
+	}
+	var err_ error
+
+	var data_ markOutboxSentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by newMessage.
 const newMessage_Operation = `
 mutation newMessage ($object: aviation_telegrams_insert_input!) {
-	insert_aviation_telegrams_one(object: $object) {
+	insert_aviation_telegrams_one(object: $object, on_conflict: {constraint:telegrams_pkey,update_columns:[category,body_data]}) {
 		message_id
 		uuid
 	}
 }
 `
 
+// Telegrams are keyed by their identity, so saving one again only refreshes
+// what was parsed from it; schedule lines and outbox records already saved are kept.
 func newMessage(
 	ctx_ context.Context,
 	client_ graphql.Client,
@@ -152,3 +781,198 @@ func newMessage(
 
 	return &data_, err_
 }
+
+// The query or mutation executed by newMessageWithOutbox.
+const newMessageWithOutbox_Operation = `
+mutation newMessageWithOutbox ($object: aviation_telegrams_insert_input!, $lines: [aviation_schedule_lines_insert_input!]!, $outbox: [aviation_outbox_insert_input!]!) {
+	insert_aviation_telegrams_one(object: $object, on_conflict: {constraint:telegrams_pkey,update_columns:[category,body_data]}) {
+		message_id
+		uuid
+	}
+	insert_aviation_schedule_lines(objects: $lines, on_conflict: {constraint:schedule_lines_pkey,update_columns:[]}) {
+		affected_rows
+	}
+	insert_aviation_outbox(objects: $outbox, on_conflict: {constraint:outbox_pkey,update_columns:[]}) {
+		affected_rows
+	}
+}
+`
+
+// The telegram, its schedule lines and the records to publish are inserted in a
+// single request, which Hasura runs in one transaction.
+func newMessageWithOutbox(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	object Aviation_telegrams_insert_input,
+	lines []Aviation_schedule_lines_insert_input,
+	outbox []Aviation_outbox_insert_input,
+) (*newMessageWithOutboxResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "newMessageWithOutbox",
+		Query:  newMessageWithOutbox_Operation,
+		Variables: &__newMessageWithOutboxInput{
+			Object: object,
+			Lines:  lines,
+			Outbox: outbox,
+		},
+	}
+	var err_ error
+
+	var data_ newMessageWithOutboxResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by outboxCandidates.
+const outboxCandidates_Operation = `
+query outboxCandidates ($limit: Int!, $expired: timestamp!) {
+	aviation_outbox(where: {sent_at:{_is_null:true},failed_at:{_is_null:true},_or:[{claimed_at:{_is_null:true}},{claimed_at:{_lt:$expired}}]}, order_by: {created_at:asc}, limit: $limit) {
+		uuid
+	}
+}
+`
+
+// outboxCandidates returns the oldest records not published, failed or
+// claimed under a lease that has not expired
+func outboxCandidates(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	limit int,
+	expired time.Time,
+) (*outboxCandidatesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "outboxCandidates",
+		Query:  outboxCandidates_Operation,
+		Variables: &__outboxCandidatesInput{
+			Limit:   limit,
+			Expired: expired,
+		},
+	}
+	var err_ error
+
+	var data_ outboxCandidatesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by ping.
+const ping_Operation = `
+query ping {
+	__typename
+}
+`
+
+// ping checks that Hasura answers queries with our credentials
+func ping(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (*pingResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ping",
+		Query:  ping_Operation,
+	}
+	var err_ error
+
+	var data_ pingResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by releaseOutbox.
+const releaseOutbox_Operation = `
+mutation releaseOutbox ($uuids: [uuid!]!, $claimed_by: String!) {
+	update_aviation_outbox(where: {uuid:{_in:$uuids},claimed_by:{_eq:$claimed_by}}, _set: {claimed_by:null,claimed_at:null}) {
+		affected_rows
+	}
+}
+`
+
+// releaseOutbox gives back the records a relay claimed but did not publish
+func releaseOutbox(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	uuids []uuid.UUID,
+	claimed_by string,
+) (*releaseOutboxResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "releaseOutbox",
+		Query:  releaseOutbox_Operation,
+		Variables: &__releaseOutboxInput{
+			Uuids:      uuids,
+			Claimed_by: claimed_by,
+		},
+	}
+	var err_ error
+
+	var data_ releaseOutboxResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by retryOutbox.
+const retryOutbox_Operation = `
+mutation retryOutbox ($uuid: uuid!, $attempts: Int!, $last_error: String!) {
+	update_aviation_outbox(where: {uuid:{_eq:$uuid}}, _set: {attempts:$attempts,last_error:$last_error,claimed_by:null,claimed_at:null}) {
+		affected_rows
+	}
+}
+`
+
+// retryOutbox records a failed attempt and releases the record for the next flush
+func retryOutbox(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	uuid uuid.UUID,
+	attempts int,
+	last_error string,
+) (*retryOutboxResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "retryOutbox",
+		Query:  retryOutbox_Operation,
+		Variables: &__retryOutboxInput{
+			Uuid:       uuid,
+			Attempts:   attempts,
+			Last_error: last_error,
+		},
+	}
+	var err_ error
+
+	var data_ retryOutboxResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
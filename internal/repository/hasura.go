@@ -4,12 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"caatsm/internal/config"
 	"caatsm/internal/domain"
+	"caatsm/internal/metrics"
+	"caatsm/internal/tracing"
 	"caatsm/pkg/utils"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/oauth2"
 )
 
@@ -32,14 +40,154 @@ func NewHasura(config *config.Config) *HasuraRepository {
 	}
 }
 
-// InsertParsedMessage inserts a new ParsedMessage into the Hasura GraphQL API
-func (hr *HasuraRepository) CreateNew(pm *domain.ParsedMessage) error {
+// CreateNew inserts a ParsedMessage into the Hasura GraphQL API, updating the
+// parsed category and body of a telegram with the same uuid
+func (hr *HasuraRepository) CreateNew(ctx context.Context, pm *domain.ParsedMessage) (err error) {
 	log := utils.GetSugaredLogger()
+	ctx, span := tracing.Start(ctx, "hasura.CreateNew", attribute.String("telegram.uuid", pm.Uuid))
+	defer func() { tracing.End(span, err) }()
+	start := time.Now()
+	resp, err := newMessage(ctx, hr.client, telegramInput(pm))
+	metrics.ObserveHasura("create_new", start, err)
+	if err != nil {
+		return err
+	}
+	// fmt.Printf("Inserted new message: %v\n", resp)
+	log.Infof("Saved : %v\n", resp)
+	return nil
+}
+
+// CreateWithOutbox inserts a telegram with the lines of its schedule, if any,
+// and the records to publish, all in one transaction. Rows already saved for
+// the same telegram are left as they are.
+func (hr *HasuraRepository) CreateWithOutbox(ctx context.Context, pm *domain.ParsedMessage, schedule *domain.Schedule, outbox []domain.OutboxRecord) (err error) {
+	log := utils.GetSugaredLogger()
+	ctx, span := tracing.Start(ctx, "hasura.CreateWithOutbox", attribute.String("telegram.uuid", pm.Uuid))
+	defer func() { tracing.End(span, err) }()
+	records := make([]Aviation_outbox_insert_input, 0, len(outbox))
+	for _, record := range outbox {
+		var traceContext, headers json.RawMessage
+		if record.TraceContext != nil {
+			traceContext, _ = json.Marshal(record.TraceContext)
+		}
+		if record.Headers != nil {
+			headers, _ = json.Marshal(record.Headers)
+		}
+		records = append(records, Aviation_outbox_insert_input{
+			Uuid:          utils.GetUuid(record.Uuid),
+			Telegram_uuid: utils.GetUuid(record.TelegramUuid),
+			Topic:         record.Topic,
+			Payload:       record.Payload,
+			Headers:       headers,
+			Trace_context: traceContext,
+			Created_at:    record.CreatedAt,
+		})
+	}
+	start := time.Now()
+	resp, err := newMessageWithOutbox(ctx, hr.client, telegramInput(pm), scheduleLineInputs(pm.Uuid, schedule), records)
+	metrics.ObserveHasura("create_with_outbox", start, err)
+	if err != nil {
+		return err
+	}
+	log.Infof("Saved %s with %d schedule lines and %d outbox records\n", resp.Insert_aviation_telegrams_one.Uuid,
+		resp.Insert_aviation_schedule_lines.Affected_rows, resp.Insert_aviation_outbox.Affected_rows)
+	return nil
+}
+
+// ClaimOutbox claims for the owner the oldest records not published yet, at
+// most limit of them, and returns those it got in the order they were saved.
+// A record claimed by another relay is skipped until its lease has expired.
+func (hr *HasuraRepository) ClaimOutbox(owner string, limit int, lease time.Duration) ([]domain.OutboxRecord, error) {
+	start := time.Now()
+	expired := start.Add(-lease)
+	candidates, err := outboxCandidates(context.Background(), hr.client, limit, expired)
+	metrics.ObserveHasura("outbox_candidates", start, err)
+	if err != nil || len(candidates.Aviation_outbox) == 0 {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, 0, len(candidates.Aviation_outbox))
+	for _, candidate := range candidates.Aviation_outbox {
+		ids = append(ids, candidate.Uuid)
+	}
+	start = time.Now()
+	resp, err := claimOutbox(context.Background(), hr.client, ids, expired, owner, start)
+	metrics.ObserveHasura("claim_outbox", start, err)
+	if err != nil {
+		return nil, err
+	}
+	claimed := resp.Update_aviation_outbox.Returning
+	records := make([]domain.OutboxRecord, 0, len(claimed))
+	for _, record := range claimed {
+		var traceContext, headers map[string]string
+		if len(record.Trace_context) > 0 {
+			json.Unmarshal(record.Trace_context, &traceContext)
+		}
+		if len(record.Headers) > 0 {
+			json.Unmarshal(record.Headers, &headers)
+		}
+		records = append(records, domain.OutboxRecord{
+			Uuid:         record.Uuid.String(),
+			TelegramUuid: record.Telegram_uuid.String(),
+			Topic:        record.Topic,
+			Payload:      record.Payload,
+			Headers:      headers,
+			TraceContext: traceContext,
+			Attempts:     record.Attempts,
+			CreatedAt:    record.Created_at,
+		})
+	}
+	sort.SliceStable(records, func(i, j int) bool { return records[i].CreatedAt.Before(records[j].CreatedAt) })
+	return records, nil
+}
+
+// MarkSent records that the outbox records were published
+func (hr *HasuraRepository) MarkSent(uuids []string) error {
+	start := time.Now()
+	_, err := markOutboxSent(context.Background(), hr.client, uuidList(uuids), start)
+	metrics.ObserveHasura("mark_sent", start, err)
+	return err
+}
+
+// ReleaseOutbox gives back the records the owner claimed but did not publish
+func (hr *HasuraRepository) ReleaseOutbox(owner string, uuids []string) error {
+	start := time.Now()
+	_, err := releaseOutbox(context.Background(), hr.client, uuidList(uuids), owner)
+	metrics.ObserveHasura("release_outbox", start, err)
+	return err
+}
+
+// RecordFailure records a failed attempt to publish an outbox record. A failed
+// record is never published again; otherwise it is released for the next flush.
+func (hr *HasuraRepository) RecordFailure(id string, attempts int, reason string, failed bool) error {
+	start := time.Now()
+	var err error
+	if failed {
+		_, err = failOutbox(context.Background(), hr.client, utils.GetUuid(id), attempts, reason, start)
+	} else {
+		_, err = retryOutbox(context.Background(), hr.client, utils.GetUuid(id), attempts, reason)
+	}
+	metrics.ObserveHasura("record_failure", start, err)
+	return err
+}
+
+func uuidList(uuids []string) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(uuids))
+	for _, id := range uuids {
+		ids = append(ids, utils.GetUuid(id))
+	}
+	return ids
+}
+
+// Ping checks that Hasura is reachable and accepts our credentials
+func (hr *HasuraRepository) Ping(ctx context.Context) error {
+	_, err := ping(ctx, hr.client)
+	return err
+}
+
+func telegramInput(pm *domain.ParsedMessage) Aviation_telegrams_insert_input {
 	bodyString, _ := json.Marshal(pm.BodyData)
 	secondAddress, _ := json.Marshal(pm.SecondaryAddresses)
-	var err error
-	msgUuid := utils.GetUuid(pm.Uuid)
-	variables := Aviation_telegrams_insert_input{
+	return Aviation_telegrams_insert_input{
 		Message_id:           pm.MessageID,
 		Priority_indicator:   pm.PriorityIndicator,
 		Primary_address:      pm.PrimaryAddress,
@@ -49,16 +197,37 @@ func (hr *HasuraRepository) CreateNew(pm *domain.ParsedMessage) error {
 		Category:             pm.Category,
 		Date_time:            pm.DateTime,
 		Dispatched_at:        pm.DispatchedAt,
-		Uuid:                 msgUuid,
+		Uuid:                 utils.GetUuid(pm.Uuid),
 		Received_at:          pm.ReceivedAt,
 		Originator:           pm.Originator,
 		Originator_date_time: pm.OriginatorDateTime,
 	}
-	resp, err := newMessage(context.Background(), hr.client, variables)
-	if err != nil {
-		return err
+}
+
+// scheduleLineInputs returns the lines of a schedule telegram, linked to the
+// telegram uuid, with uuids derived from it and the line position
+func scheduleLineInputs(telegramUuid string, schedule *domain.Schedule) []Aviation_schedule_lines_insert_input {
+	if schedule == nil {
+		return []Aviation_schedule_lines_insert_input{}
 	}
-	// fmt.Printf("Inserted new message: %v\n", resp)
-	log.Infof("Saved : %v\n", resp)
-	return nil
+	now := time.Now()
+	telegram := utils.GetUuid(telegramUuid)
+	objects := make([]Aviation_schedule_lines_insert_input, 0, len(schedule.Lines))
+	for i, line := range schedule.Lines {
+		waypoints, _ := json.Marshal(line.Waypoints)
+		objects = append(objects, Aviation_schedule_lines_insert_input{
+			Uuid:          uuid.NewSHA1(telegram, []byte(strconv.Itoa(i))),
+			Telegram_uuid: telegram,
+			Airline:       schedule.Airline,
+			Idx:           line.Index,
+			Date:          line.Date,
+			Task:          line.Task,
+			Flight_number: strings.Join(line.FlightNumber, "/"),
+			Aircraft_reg:  line.AircraftReg,
+			Waypoints:     waypoints,
+			Comments:      line.Comments,
+			Created_at:    now,
+		})
+	}
+	return objects
 }
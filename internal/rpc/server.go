@@ -0,0 +1,115 @@
+// Package rpc serves the TelegramService over gRPC: live parsed telegrams for
+// clients that cannot connect to NATS, and parsing on demand.
+package rpc
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/parsers"
+	"caatsm/internal/pb"
+	"caatsm/internal/stream"
+	"caatsm/pkg/utils"
+	"context"
+	"net"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// Server streams the telegrams of a hub and parses telegrams on request
+type Server struct {
+	pb.UnimplementedTelegramServiceServer
+	config   *config.Config
+	hub      *stream.Hub
+	server   *grpc.Server
+	stopping chan struct{}
+	once     sync.Once
+}
+
+func NewServer(config *config.Config, hub *stream.Hub) *Server {
+	s := &Server{
+		config:   config,
+		hub:      hub,
+		server:   grpc.NewServer(),
+		stopping: make(chan struct{}),
+	}
+	pb.RegisterTelegramServiceServer(s.server, s)
+	if config.GRPC.Reflection {
+		reflection.Register(s.server)
+	}
+	return s
+}
+
+// Start serves on the configured address until Shutdown is called. It does
+// nothing when no address is configured.
+func (s *Server) Start() error {
+	if s.config.GRPC.Address == "" {
+		return nil
+	}
+	listener, err := net.Listen("tcp", s.config.GRPC.Address)
+	if err != nil {
+		return err
+	}
+	go s.Serve(listener)
+	return nil
+}
+
+// Serve serves on a listener until Shutdown is called
+func (s *Server) Serve(listener net.Listener) {
+	utils.GetSugaredLogger().Infof("Serving gRPC on %s", listener.Addr())
+	if err := s.server.Serve(listener); err != nil {
+		utils.GetSugaredLogger().Errorf("gRPC server stopped: %v", err)
+	}
+}
+
+// Shutdown ends the streams and stops the server, waiting for the unary calls
+// being served until ctx is done
+func (s *Server) Shutdown(ctx context.Context) {
+	s.once.Do(func() { close(s.stopping) })
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.server.Stop()
+	}
+}
+
+// Subscribe streams the telegrams matching the request until the client
+// cancels or the server shuts down
+func (s *Server) Subscribe(request *pb.SubscribeRequest, server pb.TelegramService_SubscribeServer) error {
+	subscription := s.hub.Subscribe(stream.Filter{
+		Categories:    request.GetCategories(),
+		Originators:   request.GetOriginators(),
+		Airports:      request.GetAirports(),
+		FlightNumbers: request.GetFlightNumbers(),
+	})
+	defer subscription.Close()
+	for {
+		select {
+		case message := <-subscription.Messages():
+			if err := server.Send(pb.FromParsedMessage(message)); err != nil {
+				return err
+			}
+		case <-server.Context().Done():
+			return server.Context().Err()
+		case <-s.stopping:
+			return status.Error(codes.Unavailable, "server shutting down")
+		}
+	}
+}
+
+// Parse parses a raw telegram, as the listener does, without saving or publishing it
+func (s *Server) Parse(ctx context.Context, request *pb.ParseRequest) (*pb.ParsedMessage, error) {
+	if strings.TrimSpace(request.GetText()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "text is required")
+	}
+	parsed, _ := parsers.ParseTelegram(request.GetText())
+	return pb.FromParsedMessage(parsed), nil
+}
@@ -0,0 +1,105 @@
+package rpc
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"caatsm/internal/pb"
+	"caatsm/internal/stream"
+	"context"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		hub    *stream.Hub
+		server *Server
+		client pb.TelegramServiceClient
+	)
+
+	BeforeEach(func() {
+		hub = stream.NewHub(10, 0)
+		server = NewServer(&config.Config{}, hub)
+		listener := bufconn.Listen(1 << 20)
+		go server.Serve(listener)
+		DeferCleanup(server.Shutdown, context.Background())
+
+		conn, err := grpc.NewClient("passthrough:///bufconn",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+		client = pb.NewTelegramServiceClient(conn)
+	})
+
+	It("should stream the telegrams matching the request", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		subscription, err := client.Subscribe(ctx, &pb.SubscribeRequest{Categories: []string{"DEP"}, Airports: []string{"ZBTJ"}})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(hub.Subscribers).Should(Equal(1))
+
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "1", Category: "ARR", BodyData: &domain.ARR{DepartureAirport: "ZBTJ"}})
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "2", Category: "DEP", BodyData: &domain.DEP{DepartureAirport: "ZSHC"}})
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "3", Category: "DEP", BodyData: &domain.DEP{DepartureAirport: "ZBTJ", AircraftID: "CES5470"}})
+
+		message, err := subscription.Recv()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(message.GetUuid()).To(Equal("3"))
+		Expect(message.GetDep().GetAircraftId()).To(Equal("CES5470"))
+
+		cancel()
+		Eventually(hub.Subscribers).Should(Equal(0))
+	})
+
+	It("should end the streams when shutting down", func() {
+		subscription, err := client.Subscribe(context.Background(), &pb.SubscribeRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(hub.Subscribers).Should(Equal(1))
+
+		server.Shutdown(context.Background())
+		_, err = subscription.Recv()
+		Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		Expect(hub.Subscribers()).To(Equal(0))
+	})
+
+	It("should parse a telegram on demand", func() {
+		text := `ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`
+		message, err := client.Parse(context.Background(), &pb.ParseRequest{Text: text})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(message.GetParsed()).To(BeTrue())
+		Expect(message.GetCategory()).To(Equal("ARR"))
+		Expect(message.GetArr().GetAircraftId()).To(Equal("CES5470"))
+		Expect(message.GetArr().GetArrivalAirport()).To(Equal("ZSHC"))
+		Expect(hub.Subscribers()).To(Equal(0))
+	})
+
+	It("should parse a schedule telegram", func() {
+		text := `ZCZC TAD123 301200
+GG ZBTJZPZX ZBTJKCHU
+301158 ZBTJHUXX
+HU TSN SCHEDULE FOR 31OCT
+L05 W/Z HU7205 B5406 (9) TSN/2355(30OCT) PVG
+NNNN`
+		message, err := client.Parse(context.Background(), &pb.ParseRequest{Text: text})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(message.GetCategory()).To(Equal("SCHEDULE"))
+		Expect(message.GetSchedule().GetLines()[0].GetFlightNumber()).To(Equal([]string{"HU7205"}))
+	})
+
+	It("should reject an empty telegram", func() {
+		_, err := client.Parse(context.Background(), &pb.ParseRequest{Text: " \n"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
@@ -0,0 +1,233 @@
+// Package schema generates the JSON Schemas of the published messages from
+// the domain types, following their JSON encoding.
+package schema
+
+import (
+	"caatsm/internal/domain"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Draft is the JSON Schema dialect of the generated schemas
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema document
+type Schema map[string]interface{}
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	rawType  = reflect.TypeOf(json.RawMessage{})
+)
+
+// Names returns the names of all the schemas, sorted
+func Names() []string {
+	names := []string{domain.SchemaParsedMessage, domain.SchemaSchedule}
+	for category := range domain.BodyTypes {
+		if name := bodyName(category); name != domain.SchemaSchedule {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// All returns every schema by name, with ids under the prefix
+func All(prefix string) map[string]Schema {
+	schemas := make(map[string]Schema)
+	for _, name := range Names() {
+		schemas[name] = For(prefix, name)
+	}
+	return schemas
+}
+
+// For returns the schema of a name: parsed-message, schedule or the lower case
+// category of a body type. It returns nil for an unknown name.
+func For(prefix, name string) Schema {
+	if name == domain.SchemaParsedMessage {
+		return ParsedMessage(prefix)
+	}
+	for category, body := range domain.BodyTypes {
+		if bodyName(category) == name {
+			r := newReflector()
+			return r.document(prefix, name, r.schema(reflect.TypeOf(body)))
+		}
+	}
+	return nil
+}
+
+// ParsedMessage returns the schema of a published telegram. The shape of
+// bodyData follows the category of a parsed telegram.
+func ParsedMessage(prefix string) Schema {
+	r := newReflector()
+	root := r.schema(reflect.TypeOf(domain.ParsedMessage{}))
+
+	categories := make([]string, 0, len(domain.BodyTypes))
+	for category := range domain.BodyTypes {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	bodies := []interface{}{Schema{"type": "null"}}
+	conditions := []interface{}{}
+	for _, category := range categories {
+		ref := r.schema(reflect.TypeOf(domain.BodyTypes[category]))
+		bodies = append(bodies, ref)
+		conditions = append(conditions, Schema{
+			"if": Schema{
+				"properties": Schema{"category": Schema{"const": category}, "parsed": Schema{"const": true}},
+				"required":   []string{"category", "parsed"},
+			},
+			"then": Schema{"properties": Schema{"bodyData": ref}},
+		})
+	}
+	properties := r.defs["ParsedMessage"]["properties"].(Schema)
+	properties["bodyData"] = Schema{"anyOf": bodies}
+	properties["schemaVersion"] = Schema{"const": domain.SchemaVersion}
+	document := r.document(prefix, domain.SchemaParsedMessage, root)
+	document["allOf"] = conditions
+	return document
+}
+
+// bodyName returns the schema name of the body type of a category
+func bodyName(category string) string {
+	return strings.ToLower(category)
+}
+
+// reflector builds schemas from Go types, keeping each struct in $defs
+type reflector struct {
+	defs map[string]Schema
+}
+
+func newReflector() *reflector {
+	return &reflector{defs: make(map[string]Schema)}
+}
+
+// document returns the root schema, its type inlined from $defs
+func (r *reflector) document(prefix, name string, root Schema) Schema {
+	document := Schema{
+		"$schema": Draft,
+		"$id":     domain.SchemaID(prefix, name),
+		"title":   name,
+		"version": domain.SchemaVersion,
+	}
+	ref := strings.TrimPrefix(root["$ref"].(string), "#/$defs/")
+	for key, value := range r.defs[ref] {
+		document[key] = value
+	}
+	delete(r.defs, ref)
+	// references to the root type point to the document itself
+	for _, def := range r.defs {
+		replaceRef(def, "#/$defs/"+ref, "#")
+	}
+	replaceRef(document, "#/$defs/"+ref, "#")
+	if len(r.defs) > 0 {
+		document["$defs"] = r.defs
+	}
+	return document
+}
+
+// schema returns the schema of a type, a reference for a struct
+func (r *reflector) schema(t reflect.Type) Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch {
+	case t == timeType:
+		return Schema{"type": "string", "format": "date-time"}
+	case t == rawType:
+		return Schema{}
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return Schema{"type": "array", "items": r.schema(t.Elem())}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": r.schema(t.Elem())}
+	case reflect.Struct:
+		return r.object(t)
+	}
+	return Schema{}
+}
+
+// object adds a struct to $defs and returns a reference to it
+func (r *reflector) object(t reflect.Type) Schema {
+	ref := Schema{"$ref": "#/$defs/" + t.Name()}
+	if _, ok := r.defs[t.Name()]; ok {
+		return ref
+	}
+	properties := Schema{}
+	required := []string{}
+	def := Schema{"type": "object", "properties": properties}
+	r.defs[t.Name()] = def
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, omitempty := jsonName(field)
+		if name == "-" {
+			continue
+		}
+		property := r.schema(field.Type)
+		if !omitempty && nullable(field.Type) {
+			property = Schema{"anyOf": []interface{}{property, Schema{"type": "null"}}}
+		}
+		properties[name] = property
+		if !omitempty {
+			required = append(required, name)
+		}
+	}
+	if len(required) > 0 {
+		def["required"] = required
+	}
+	return ref
+}
+
+// jsonName returns the key of a field in the JSON encoding
+func jsonName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	name, options, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, strings.Contains(","+options+",", ",omitempty,")
+}
+
+// nullable reports a type encoded as null when it is nil
+func nullable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return t != rawType
+	}
+	return false
+}
+
+func replaceRef(value interface{}, from, to string) {
+	switch v := value.(type) {
+	case Schema:
+		if v["$ref"] == from {
+			v["$ref"] = to
+		}
+		for _, child := range v {
+			replaceRef(child, from, to)
+		}
+	case map[string]Schema:
+		for _, child := range v {
+			replaceRef(child, from, to)
+		}
+	case []interface{}:
+		for _, child := range v {
+			replaceRef(child, from, to)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"caatsm/internal/parsers"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// conform checks that the keys of a JSON object are properties of the schema
+// and the required properties are present
+func conform(object map[string]interface{}, schema Schema) {
+	properties := schema["properties"].(Schema)
+	for key := range object {
+		Expect(properties).To(HaveKey(key))
+	}
+	if required, ok := schema["required"].([]string); ok {
+		for _, key := range required {
+			Expect(object).To(HaveKey(key))
+		}
+	}
+}
+
+func encode(value interface{}) map[string]interface{} {
+	data, err := json.Marshal(value)
+	Expect(err).NotTo(HaveOccurred())
+	var object map[string]interface{}
+	Expect(json.Unmarshal(data, &object)).To(Succeed())
+	return object
+}
+
+var _ = Describe("Schema", func() {
+	It("should describe a parsed telegram and its body", func() {
+		parsed := parsers.Parse(`ZCZC TMQ2530 141614
+GG ZBTJZXZX
+141614 ZSHCZTZX
+(ARR-CES5470-ZBTJ-ZSHC1614)
+NNNN`)
+		Expect(parsed.SchemaVersion).To(Equal(domain.SchemaVersion))
+		document := ParsedMessage("urn:caatsm:schema:")
+		Expect(document["$id"]).To(Equal("urn:caatsm:schema:parsed-message/" + domain.SchemaVersion))
+
+		object := encode(parsed)
+		conform(object, document)
+		defs := document["$defs"].(map[string]Schema)
+		conform(object["bodyData"].(map[string]interface{}), defs["ARR"])
+	})
+
+	It("should choose the body by category", func() {
+		document := ParsedMessage("urn:caatsm:schema:")
+		conditions := document["allOf"].([]interface{})
+		Expect(conditions).To(HaveLen(len(domain.BodyTypes)))
+		Expect(conditions).To(ContainElement(Schema{
+			"if": Schema{
+				"properties": Schema{"category": Schema{"const": "FPL"}, "parsed": Schema{"const": true}},
+				"required":   []string{"category", "parsed"},
+			},
+			"then": Schema{"properties": Schema{"bodyData": Schema{"$ref": "#/$defs/FPL"}}},
+		}))
+	})
+
+	It("should describe a schedule on its own", func() {
+		document := For("urn:caatsm:schema:", domain.SchemaSchedule)
+		Expect(document["$id"]).To(Equal("urn:caatsm:schema:schedule/" + domain.SchemaVersion))
+		conform(encode(domain.Schedule{Airline: "HU", Lines: []domain.ScheduleLine{}}), document)
+		Expect(document["$defs"]).To(HaveKey("ScheduleLine"))
+	})
+
+	It("should return nothing for an unknown name", func() {
+		Expect(For("urn:caatsm:schema:", "metar")).To(BeNil())
+		Expect(Names()).To(ContainElements("parsed-message", "schedule", "arr", "fpl"))
+	})
+
+	It("should match the published schemas of the current version", func() {
+		// regenerate with 'task json-schema', bumping domain.SchemaVersion when the messages change
+		for name, document := range All(config.DefaultDataSchema) {
+			published, err := os.ReadFile(filepath.Join("..", "..", "schemas", domain.SchemaVersion, name+".schema.json"))
+			Expect(err).NotTo(HaveOccurred(), name)
+			generated, err := json.MarshalIndent(document, "", "  ")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(published)).To(Equal(string(generated)+"\n"), name)
+		}
+	})
+})
@@ -0,0 +1,201 @@
+package stream
+
+import (
+	"caatsm/internal/config"
+	"caatsm/pkg/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// writeTimeout bounds sending a telegram to a WebSocket client
+const writeTimeout = 10 * time.Second
+
+// Feed serves the telegrams of a hub to browsers, as Server-Sent Events and
+// over WebSocket. The query parameters category, originator, airport and
+// flight filter the telegrams, each repeated or comma separated, and replay
+// sets how many of the latest ones are sent first.
+type Feed struct {
+	config   config.LiveConfig
+	hub      *Hub
+	upgrader websocket.Upgrader
+	stopping chan struct{}
+	once     sync.Once
+}
+
+func NewFeed(config config.LiveConfig, hub *Hub) *Feed {
+	f := &Feed{config: config, hub: hub, stopping: make(chan struct{})}
+	f.upgrader.CheckOrigin = f.checkOrigin
+	return f
+}
+
+// Close ends the streams being served, so the server can shut down
+func (f *Feed) Close() {
+	f.once.Do(func() { close(f.stopping) })
+}
+
+// ServeEvents streams the telegrams as Server-Sent Events, each a 'telegram'
+// event with the uuid as its id and the JSON message as its data
+func (f *Feed) ServeEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	subscription, err := f.subscribe(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer subscription.Close()
+	if origin := r.Header.Get("Origin"); origin != "" && f.allowed(origin) {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepAlive := f.keepAlive()
+	defer keepAlive.Stop()
+	for {
+		select {
+		case message := <-subscription.Messages():
+			data, err := json.Marshal(message)
+			if err != nil {
+				utils.GetSugaredLogger().Errorf("Failed to marshal live telegram %s: %v", message.Uuid, err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: telegram\ndata: %s\n\n", message.Uuid, data); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case <-r.Context().Done():
+			return
+		case <-f.stopping:
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// ServeWebSocket streams the telegrams over WebSocket, each as a text message
+// holding the JSON message. Messages from the client are ignored.
+func (f *Feed) ServeWebSocket(w http.ResponseWriter, r *http.Request) {
+	subscription, err := f.subscribe(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer subscription.Close()
+	conn, err := f.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	// reading handles the control messages and notices the client leaving
+	gone := make(chan struct{})
+	go func() {
+		defer close(gone)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	keepAlive := f.keepAlive()
+	defer keepAlive.Stop()
+	for {
+		select {
+		case message := <-subscription.Messages():
+			conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if err := conn.WriteJSON(message); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout)); err != nil {
+				return
+			}
+		case <-gone:
+			return
+		case <-f.stopping:
+			conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"), time.Now().Add(writeTimeout))
+			return
+		}
+	}
+}
+
+// subscribe subscribes to the hub with the filter and replay of the query
+func (f *Feed) subscribe(query url.Values) (*Subscription, error) {
+	replay := f.config.Replay
+	if value := query.Get("replay"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid replay: %s", value)
+		}
+		replay = n
+	}
+	filter := Filter{
+		Categories:    values(query, "category"),
+		Originators:   values(query, "originator"),
+		Airports:      values(query, "airport"),
+		FlightNumbers: values(query, "flight"),
+	}
+	return f.hub.SubscribeReplay(filter, min(replay, f.hub.History())), nil
+}
+
+// values returns the values of a repeated or comma separated query parameter
+func values(query url.Values, key string) []string {
+	var result []string
+	for _, value := range query[key] {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+// keepAlive returns the ticker of the pings, one that never fires when they are off
+func (f *Feed) keepAlive() *time.Ticker {
+	if f.config.KeepAlive <= 0 {
+		ticker := time.NewTicker(time.Hour)
+		ticker.Stop()
+		return ticker
+	}
+	return time.NewTicker(f.config.KeepAlive)
+}
+
+// checkOrigin accepts a WebSocket from the same host or an allowed origin
+func (f *Feed) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || f.allowed(origin) {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+func (f *Feed) allowed(origin string) bool {
+	for _, allowed := range f.config.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
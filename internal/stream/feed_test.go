@@ -0,0 +1,136 @@
+package stream
+
+import (
+	"bufio"
+	"caatsm/internal/config"
+	"caatsm/internal/domain"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feed", func() {
+	var (
+		hub    *Hub
+		feed   *Feed
+		server *httptest.Server
+	)
+
+	BeforeEach(func() {
+		hub = NewHub(10, 5)
+		feed = NewFeed(config.LiveConfig{Replay: 1, AllowedOrigins: []string{"http://wall.local"}}, hub)
+		mux := http.NewServeMux()
+		mux.HandleFunc("/live/events", feed.ServeEvents)
+		mux.HandleFunc("/live/ws", feed.ServeWebSocket)
+		server = httptest.NewServer(mux)
+		DeferCleanup(server.Close)
+		DeferCleanup(feed.Close)
+	})
+
+	// event reads the next event of a stream, skipping comments
+	event := func(reader *bufio.Reader) []string {
+		var lines []string
+		for {
+			line, err := reader.ReadString('\n')
+			Expect(err).NotTo(HaveOccurred())
+			line = strings.TrimSuffix(line, "\n")
+			if line == "" && len(lines) > 0 {
+				return lines
+			}
+			if line != "" && !strings.HasPrefix(line, ":") {
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	It("should stream the filtered telegrams as server-sent events after the replay", func() {
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "1", Category: "ARR", Originator: "ZSHCZTZX"})
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "2", Category: "ARR", Originator: "ZSHCZTZX"})
+		request, err := http.NewRequest(http.MethodGet, server.URL+"/live/events?category=arr,dep&originator=ZSHCZTZX", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set("Origin", "http://wall.local")
+		response, err := http.DefaultClient.Do(request)
+		Expect(err).NotTo(HaveOccurred())
+		defer response.Body.Close()
+		Expect(response.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+		Expect(response.Header.Get("Access-Control-Allow-Origin")).To(Equal("http://wall.local"))
+		reader := bufio.NewReader(response.Body)
+
+		Expect(event(reader)[0]).To(Equal("id: 2"))
+		Eventually(hub.Subscribers).Should(Equal(1))
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "3", Category: "DEP", Originator: "ZSHCZTZX"})
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "4", Category: "DEP", Originator: "ZBAAZPZX"})
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "5", Category: "DEP", Originator: "ZSHCZTZX"})
+
+		lines := event(reader)
+		Expect(lines[:2]).To(Equal([]string{"id: 3", "event: telegram"}))
+		var message domain.ParsedMessage
+		Expect(json.Unmarshal([]byte(strings.TrimPrefix(lines[2], "data: ")), &message)).To(Succeed())
+		Expect(message.Category).To(Equal("DEP"))
+		Expect(event(reader)[0]).To(Equal("id: 5"))
+	})
+
+	It("should end the event streams when closed", func() {
+		response, err := http.Get(server.URL + "/live/events?replay=0")
+		Expect(err).NotTo(HaveOccurred())
+		defer response.Body.Close()
+		Eventually(hub.Subscribers).Should(Equal(1))
+		feed.Close()
+		Eventually(hub.Subscribers).Should(Equal(0))
+	})
+
+	It("should reject an invalid replay", func() {
+		response, err := http.Get(server.URL + "/live/events?replay=all")
+		Expect(err).NotTo(HaveOccurred())
+		defer response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should stream the filtered telegrams over WebSocket after the replay", func() {
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "1", Category: "FPL", BodyData: &domain.FPL{FlightNumber: "CES5470"}})
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "2", Category: "FPL", BodyData: &domain.FPL{FlightNumber: "CSN3101"}})
+		url := "ws" + strings.TrimPrefix(server.URL, "http") + "/live/ws?flight=CES5470&flight=CCA1501&replay=5"
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		Expect(err).NotTo(HaveOccurred())
+		defer conn.Close()
+
+		read := func() string {
+			conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+			var message domain.ParsedMessage
+			_, data, err := conn.ReadMessage()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(json.Unmarshal(data, &message)).To(Succeed())
+			return message.Uuid
+		}
+		Expect(read()).To(Equal("1"))
+		Eventually(hub.Subscribers).Should(Equal(1))
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "3", Category: "DLA", BodyData: &domain.DLA{AircraftID: "CCA1501"}})
+		Expect(read()).To(Equal("3"))
+
+		feed.Close()
+		_, _, err = conn.ReadMessage()
+		Expect(websocket.IsCloseError(err, websocket.CloseGoingAway)).To(BeTrue())
+		Eventually(hub.Subscribers).Should(Equal(0))
+	})
+
+	It("should only accept WebSockets from the same host or an allowed origin", func() {
+		url := "ws" + strings.TrimPrefix(server.URL, "http") + "/live/ws"
+		_, response, err := websocket.DefaultDialer.Dial(url, http.Header{"Origin": {"http://elsewhere.local"}})
+		Expect(err).To(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+
+		conn, _, err := websocket.DefaultDialer.Dial(url, http.Header{"Origin": {"http://wall.local"}})
+		Expect(err).NotTo(HaveOccurred())
+		conn.Close()
+		conn, _, err = websocket.DefaultDialer.Dial(url, http.Header{"Origin": {server.URL}})
+		Expect(err).NotTo(HaveOccurred())
+		conn.Close()
+		Eventually(hub.Subscribers).Should(Equal(0))
+	})
+})
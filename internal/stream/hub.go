@@ -0,0 +1,170 @@
+// Package stream fans the telegrams parsed by the listener out to the clients
+// subscribed to them.
+package stream
+
+import (
+	"caatsm/internal/domain"
+	"caatsm/internal/metrics"
+	"caatsm/pkg/utils"
+	"strings"
+	"sync"
+)
+
+// Filter selects the telegrams of a subscription. A telegram matches when it
+// matches every filter given, any of its values; an empty filter matches all.
+// Values are compared regardless of case.
+type Filter struct {
+	Categories    []string // 类别: e.g. 'FPL'
+	Originators   []string // 发件人: originator address
+	Airports      []string // 机场: ICAO or IATA code of an airport of the telegram
+	FlightNumbers []string // 航班号: flight number or aircraft identification
+}
+
+// Match reports whether a telegram passes the filter
+func (f Filter) Match(message *domain.ParsedMessage) bool {
+	return matchAny(f.Categories, message.Category) &&
+		matchAny(f.Originators, message.Originator) &&
+		matchAny(f.Airports, airports(message)...) &&
+		matchAny(f.FlightNumbers, domain.FlightNumbers(message.BodyData)...)
+}
+
+// matchAny reports whether one of the values is wanted, true when nothing is wanted
+func matchAny(wanted []string, values ...string) bool {
+	if len(wanted) == 0 {
+		return true
+	}
+	for _, w := range wanted {
+		for _, value := range values {
+			if value != "" && strings.EqualFold(w, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// airports returns the codes of the airports of a telegram, along its route
+// or the waypoints of a schedule
+func airports(message *domain.ParsedMessage) []string {
+	codes := domain.AirportCodes(message.BodyData)
+	for _, airport := range message.Airports {
+		codes = append(codes, airport.ICAO, airport.IATA)
+	}
+	if schedule, ok := message.BodyData.(*domain.Schedule); ok {
+		for _, line := range schedule.Lines {
+			for _, waypoint := range line.Waypoints {
+				codes = append(codes, waypoint.Airport, waypoint.AirportICAO)
+			}
+		}
+	}
+	return codes
+}
+
+// Hub hands each telegram to the subscriptions it matches, and keeps the
+// latest ones to replay to new subscriptions. It is a MessageListener of the
+// handler.
+type Hub struct {
+	buffer        int
+	history       int
+	mu            sync.RWMutex
+	subscriptions map[*Subscription]struct{}
+	recent        []*domain.ParsedMessage
+}
+
+// NewHub returns a hub queuing up to buffer telegrams for each subscription
+// and keeping the latest history telegrams for replay
+func NewHub(buffer, history int) *Hub {
+	if buffer < 1 {
+		buffer = 1
+	}
+	if history < 0 {
+		history = 0
+	}
+	return &Hub{buffer: buffer, history: history, subscriptions: make(map[*Subscription]struct{})}
+}
+
+// Subscription receives the telegrams matching its filter until it is closed
+type Subscription struct {
+	hub      *Hub
+	filter   Filter
+	messages chan *domain.ParsedMessage
+	once     sync.Once
+}
+
+// Subscribe returns a subscription to the telegrams matching the filter
+func (h *Hub) Subscribe(filter Filter) *Subscription {
+	return h.SubscribeReplay(filter, 0)
+}
+
+// SubscribeReplay returns a subscription to the telegrams matching the filter
+// that starts with up to replay of the latest ones kept by the hub, oldest first
+func (h *Hub) SubscribeReplay(filter Filter, replay int) *Subscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var replayed []*domain.ParsedMessage
+	for i := len(h.recent) - 1; i >= 0 && len(replayed) < replay; i-- {
+		if filter.Match(h.recent[i]) {
+			replayed = append(replayed, h.recent[i])
+		}
+	}
+	s := &Subscription{hub: h, filter: filter, messages: make(chan *domain.ParsedMessage, h.buffer+len(replayed))}
+	for i := len(replayed) - 1; i >= 0; i-- {
+		s.messages <- replayed[i]
+	}
+	h.subscriptions[s] = struct{}{}
+	metrics.StreamSubscribers.Inc()
+	return s
+}
+
+// History returns how many of the latest telegrams the hub keeps for replay
+func (h *Hub) History() int {
+	return h.history
+}
+
+// Subscribers returns the number of open subscriptions
+func (h *Hub) Subscribers() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subscriptions)
+}
+
+// OnMessage keeps a telegram for replay and hands it to the matching
+// subscriptions without waiting: it is dropped for a subscription whose buffer
+// is full.
+func (h *Hub) OnMessage(message *domain.ParsedMessage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.history > 0 {
+		if len(h.recent) == h.history {
+			h.recent = append(h.recent[:0], h.recent[1:]...)
+		}
+		h.recent = append(h.recent, message)
+	}
+	for s := range h.subscriptions {
+		if !s.filter.Match(message) {
+			continue
+		}
+		select {
+		case s.messages <- message:
+		default:
+			metrics.StreamDropped.Inc()
+			utils.GetSugaredLogger().Warnf("Live telegram %s dropped for a slow client", message.Uuid)
+		}
+	}
+}
+
+// Messages returns the channel of the telegrams, closed with the subscription
+func (s *Subscription) Messages() <-chan *domain.ParsedMessage {
+	return s.messages
+}
+
+// Close ends the subscription. It may be called more than once.
+func (s *Subscription) Close() {
+	s.once.Do(func() {
+		s.hub.mu.Lock()
+		delete(s.hub.subscriptions, s)
+		s.hub.mu.Unlock()
+		close(s.messages)
+		metrics.StreamSubscribers.Dec()
+	})
+}
@@ -0,0 +1,110 @@
+package stream
+
+import (
+	"caatsm/internal/domain"
+	"caatsm/internal/metrics"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Filter", func() {
+	arr := &domain.ParsedMessage{
+		Category:   "ARR",
+		Originator: "ZSHCZTZX",
+		Airports:   []domain.Airport{{ICAO: "ZBTJ", IATA: "TSN"}},
+		BodyData:   &domain.ARR{AircraftID: "CES5470", DepartureAirport: "ZBTJ", ArrivalAirport: "ZSHC"},
+	}
+
+	It("should match everything without filters", func() {
+		Expect(Filter{}.Match(arr)).To(BeTrue())
+	})
+
+	It("should match any value of a filter, regardless of case", func() {
+		Expect(Filter{Categories: []string{"fpl", "arr"}}.Match(arr)).To(BeTrue())
+		Expect(Filter{Originators: []string{"ZBAAZPZX"}}.Match(arr)).To(BeFalse())
+		Expect(Filter{Airports: []string{"ZSHC"}}.Match(arr)).To(BeTrue())
+		Expect(Filter{Airports: []string{"tsn"}}.Match(arr)).To(BeTrue())
+		Expect(Filter{FlightNumbers: []string{"CES5470"}}.Match(arr)).To(BeTrue())
+	})
+
+	It("should match every filter given", func() {
+		Expect(Filter{Categories: []string{"ARR"}, FlightNumbers: []string{"CES5470"}}.Match(arr)).To(BeTrue())
+		Expect(Filter{Categories: []string{"ARR"}, FlightNumbers: []string{"CSN3101"}}.Match(arr)).To(BeFalse())
+	})
+
+	It("should match the waypoints and flight numbers of a schedule", func() {
+		schedule := &domain.ParsedMessage{Category: "SCHEDULE", BodyData: &domain.Schedule{Lines: []domain.ScheduleLine{{
+			FlightNumber: []string{"HU7205"},
+			Waypoints:    []domain.WayPoint{{Airport: "TSN", AirportICAO: "ZBTJ"}, {Airport: "PVG"}},
+		}}}}
+		Expect(Filter{Airports: []string{"PVG"}, FlightNumbers: []string{"HU7205"}}.Match(schedule)).To(BeTrue())
+		Expect(Filter{Airports: []string{"ZSPD"}}.Match(schedule)).To(BeFalse())
+	})
+})
+
+var _ = Describe("Hub", func() {
+	It("should hand the telegrams to the matching subscriptions", func() {
+		hub := NewHub(10, 0)
+		fpl := hub.Subscribe(Filter{Categories: []string{"FPL"}})
+		all := hub.Subscribe(Filter{})
+		DeferCleanup(fpl.Close)
+		DeferCleanup(all.Close)
+
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "1", Category: "ARR"})
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "2", Category: "FPL"})
+
+		Expect((<-fpl.Messages()).Uuid).To(Equal("2"))
+		Expect(fpl.Messages()).To(BeEmpty())
+		Expect((<-all.Messages()).Uuid).To(Equal("1"))
+		Expect((<-all.Messages()).Uuid).To(Equal("2"))
+	})
+
+	It("should drop the telegrams of a subscription that is full", func() {
+		hub := NewHub(1, 0)
+		subscription := hub.Subscribe(Filter{})
+		DeferCleanup(subscription.Close)
+		dropped := testutil.ToFloat64(metrics.StreamDropped)
+
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "1"})
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "2"})
+
+		Expect(testutil.ToFloat64(metrics.StreamDropped)).To(Equal(dropped + 1))
+		Expect((<-subscription.Messages()).Uuid).To(Equal("1"))
+	})
+
+	It("should replay the latest matching telegrams to a new subscription", func() {
+		hub := NewHub(1, 3)
+		for _, message := range []*domain.ParsedMessage{
+			{Uuid: "1", Category: "FPL"}, {Uuid: "2", Category: "ARR"}, {Uuid: "3", Category: "FPL"},
+			{Uuid: "4", Category: "DEP"}, {Uuid: "5", Category: "FPL"},
+		} {
+			hub.OnMessage(message)
+		}
+		subscription := hub.SubscribeReplay(Filter{Categories: []string{"FPL"}}, 5)
+		DeferCleanup(subscription.Close)
+		Expect((<-subscription.Messages()).Uuid).To(Equal("3"))
+		Expect((<-subscription.Messages()).Uuid).To(Equal("5"))
+
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "6", Category: "FPL"})
+		Expect((<-subscription.Messages()).Uuid).To(Equal("6"))
+
+		latest := hub.SubscribeReplay(Filter{}, 1)
+		DeferCleanup(latest.Close)
+		Expect((<-latest.Messages()).Uuid).To(Equal("6"))
+		Expect(hub.Subscribe(Filter{}).Messages()).To(BeEmpty())
+	})
+
+	It("should stop handing telegrams to a closed subscription", func() {
+		hub := NewHub(1, 0)
+		subscription := hub.Subscribe(Filter{})
+		Expect(hub.Subscribers()).To(Equal(1))
+		subscription.Close()
+		subscription.Close()
+		Expect(hub.Subscribers()).To(Equal(0))
+
+		hub.OnMessage(&domain.ParsedMessage{Uuid: "1"})
+		Eventually(subscription.Messages()).Should(BeClosed())
+	})
+})
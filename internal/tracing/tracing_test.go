@@ -0,0 +1,66 @@
+package tracing
+
+import (
+	"caatsm/internal/config"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	nc "github.com/nats-io/nats.go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+var _ = Describe("Tracing", func() {
+	It("should carry the trace context through fields", func() {
+		ctx := Extract(context.Background(), map[string]string{"traceparent": traceparent})
+		span := trace.SpanContextFromContext(ctx)
+		Expect(span.IsValid()).To(BeTrue())
+		Expect(span.TraceID().String()).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+		Expect(Inject(ctx)).To(HaveKeyWithValue("traceparent", traceparent))
+	})
+
+	It("should carry the trace context through NATS headers", func() {
+		header := nc.Header{}
+		header.Set("traceparent", traceparent)
+		ctx := ExtractHeader(context.Background(), header)
+		Expect(trace.SpanContextFromContext(ctx).SpanID().String()).To(Equal("00f067aa0ba902b7"))
+		Expect(ExtractHeader(ctx, nil)).To(Equal(ctx))
+	})
+
+	It("should inject nothing without a span", func() {
+		Expect(Inject(context.Background())).To(BeNil())
+	})
+
+	It("should reject an unknown exporter", func() {
+		_, err := Setup(config.TracingConfig{Exporter: "zipkin"})
+		Expect(err).To(MatchError(ContainSubstring("invalid tracing exporter")))
+	})
+
+	It("should write spans to the file exporter", func() {
+		provider := otel.GetTracerProvider()
+		DeferCleanup(func() { otel.SetTracerProvider(provider) })
+		file := filepath.Join(GinkgoT().TempDir(), "spans.json")
+		shutdown, err := Setup(config.TracingConfig{Exporter: ExporterFile, File: file, SampleRatio: 1})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := Extract(context.Background(), map[string]string{"traceparent": traceparent})
+		ctx, parent := Start(ctx, "parent")
+		_, child := Start(ctx, "child")
+		End(child, errors.New("failed"))
+		End(parent, nil)
+		Expect(shutdown(context.Background())).To(Succeed())
+
+		spans, err := os.ReadFile(file)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(spans)).To(ContainSubstring(`"Name":"child"`))
+		Expect(string(spans)).To(ContainSubstring(`"Name":"parent"`))
+		Expect(string(spans)).To(ContainSubstring("4bf92f3577b34da6a3ce929d0e0e4736"))
+		Expect(string(spans)).To(ContainSubstring(`"Description":"failed"`))
+	})
+})
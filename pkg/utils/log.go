@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sync"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -33,11 +34,17 @@ type LumberjackConfig struct {
 }
 
 var (
-	sugar *zap.SugaredLogger
-	log   *zap.Logger
+	sugar    *zap.SugaredLogger
+	log      *zap.Logger
+	loadOnce sync.Once
 )
 
+// load builds the logger once, so it can be called from concurrent workers
 func load() {
+	loadOnce.Do(build)
+}
+
+func build() {
 	if log == nil {
 		env := getEnv()
 		// fmt.Printf("Environment: %s\n", env)
@@ -117,12 +124,18 @@ func loadConfig(configFile string) (LoggerConfig, error) {
 }
 
 func GetLogger() *zap.SugaredLogger {
-	if sugar == nil {
-		load()
-	}
+	load()
 	return sugar
 }
 
+// SyncLogger flushes the buffered log entries
+func SyncLogger() error {
+	if log == nil {
+		return nil
+	}
+	return log.Sync()
+}
+
 func parseLogLevel(level string) zapcore.Level {
 	switch level {
 	case "debug":